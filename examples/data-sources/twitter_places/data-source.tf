@@ -0,0 +1,12 @@
+data "twitter_places" "venue" {
+  query       = "Moscone Center"
+  granularity = "poi"
+}
+
+resource "twitter_tweet" "event" {
+  text = "See you at the conference!"
+
+  location {
+    place_id = data.twitter_places.venue.places[0].id
+  }
+}
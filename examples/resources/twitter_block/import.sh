@@ -0,0 +1,5 @@
+# Blocks can be imported by specifying the numeric user ID of the blocked account.
+terraform import twitter_block.spam 2244994945
+
+# Alternatively, the screen name prefixed with @ can be used.
+terraform import twitter_block.spam @TwitterDev
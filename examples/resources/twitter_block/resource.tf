@@ -0,0 +1,3 @@
+resource "twitter_block" "spam" {
+  screen_name = "TwitterDev"
+}
@@ -0,0 +1,2 @@
+# Bookmarks can be imported by specifying the numeric ID of the bookmarked tweet.
+terraform import twitter_bookmark.reference 1559537820804399104
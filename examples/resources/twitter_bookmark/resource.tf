@@ -0,0 +1,3 @@
+resource "twitter_bookmark" "reference" {
+  tweet_id = 1559537820804399104
+}
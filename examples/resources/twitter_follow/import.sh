@@ -0,0 +1,5 @@
+# Follows can be imported by specifying the numeric user ID of the followed account.
+terraform import twitter_follow.test 290900886
+
+# Alternatively, the screen name prefixed with @ can be used.
+terraform import twitter_follow.test @HashiCorp
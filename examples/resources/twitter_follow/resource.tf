@@ -1,3 +1,10 @@
 resource "twitter_follow" "test" {
   screen_name = "HashiCorp"
 }
+
+# Following a protected user sends a follow request, the apply waits up to a
+# day for it to be accepted.
+resource "twitter_follow" "protected" {
+  screen_name         = "Terraformpriva1"
+  wait_for_acceptance = "24h"
+}
@@ -0,0 +1,5 @@
+# The following list of the authenticated user can be imported with the special
+# ID "me" or with its numeric user ID. Followed users are identified by their
+# numeric IDs. They were not followed by this resource, so they are only
+# unfollowed when exclusive is set.
+terraform import twitter_follows.following me
@@ -0,0 +1,10 @@
+resource "twitter_follows" "following" {
+  users = [
+    "@HashiCorp",
+    "golang",
+    "6253282",
+  ]
+
+  # Unfollow every account that is not listed above.
+  exclusive = true
+}
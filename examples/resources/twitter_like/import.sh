@@ -0,0 +1,2 @@
+# Likes can be imported by specifying the numeric ID of the liked tweet.
+terraform import twitter_like.curated 1559537820804399104
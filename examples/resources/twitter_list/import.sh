@@ -0,0 +1,4 @@
+# Lists owned by the authenticated user can be imported with their numeric ID
+# or with the screen name of their owner and their slug.
+terraform import twitter_list.maintainers 1234567890
+terraform import twitter_list.maintainers jack/maintainers
@@ -0,0 +1,5 @@
+resource "twitter_list" "maintainers" {
+  name        = "Maintainers"
+  description = "People maintaining the projects we depend on"
+  mode        = "private"
+}
@@ -0,0 +1,3 @@
+# The members of a list can be imported with the numeric ID of the list, they
+# are identified by their screen names.
+terraform import twitter_list_members.maintainers 1234567890
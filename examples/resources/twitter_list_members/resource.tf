@@ -0,0 +1,12 @@
+resource "twitter_list" "maintainers" {
+  name = "Maintainers"
+}
+
+resource "twitter_list_members" "maintainers" {
+  list_id = twitter_list.maintainers.id
+  members = [
+    "@golang",
+    "hashicorp",
+    "783214",
+  ]
+}
@@ -0,0 +1,2 @@
+# List subscriptions can be imported by specifying the numeric ID of the list.
+terraform import twitter_list_subscription.partners 1130185227375038465
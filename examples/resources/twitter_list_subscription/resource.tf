@@ -0,0 +1,3 @@
+resource "twitter_list_subscription" "partners" {
+  list_id = 1130185227375038465
+}
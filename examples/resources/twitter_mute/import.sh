@@ -0,0 +1,5 @@
+# Mutes can be imported by specifying the numeric user ID of the muted account.
+terraform import twitter_mute.spam 6253282
+
+# Alternatively, the screen name prefixed with @ can be used.
+terraform import twitter_mute.spam @TwitterAPI
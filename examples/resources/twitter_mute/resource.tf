@@ -0,0 +1,3 @@
+resource "twitter_mute" "spam" {
+  screen_name = "TwitterAPI"
+}
@@ -0,0 +1,3 @@
+# The pinned lists of the authenticated user can be imported with the special ID
+# "me" or with its numeric user ID.
+terraform import twitter_pinned_lists.pinned me
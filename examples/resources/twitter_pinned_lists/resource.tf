@@ -0,0 +1,10 @@
+resource "twitter_list" "maintainers" {
+  name = "Maintainers"
+}
+
+resource "twitter_pinned_lists" "pinned" {
+  list_ids = [
+    twitter_list.maintainers.id,
+    1130185227375038465,
+  ]
+}
@@ -0,0 +1,3 @@
+# The profile of the authenticated user can be imported with the special ID "me"
+# or with its numeric user ID. Importing does not modify the profile.
+terraform import twitter_profile.me me
@@ -0,0 +1,2 @@
+# Retweets can be imported by specifying the numeric ID of the retweeted tweet.
+terraform import twitter_retweet.announcement 1559537820804399104
@@ -0,0 +1,3 @@
+resource "twitter_retweet" "announcement" {
+  tweet_id = 1559537820804399104
+}
@@ -0,0 +1,7 @@
+resource "twitter_thread" "release_notes" {
+  tweets = [
+    "Version 1.0 of our provider is out! Here is what changed 🧵",
+    "Tweets, follows and profiles can now be imported into Terraform.",
+    "Read the full changelog at https://registry.terraform.io/providers/sebastianmarines/twitter/latest",
+  ]
+}
@@ -0,0 +1,6 @@
+# Tweets can be imported by specifying the numeric tweet ID. The files of the
+# media attached to an imported tweet are unknown, so declaring media blocks
+# for it replaces the tweet on the next apply. The location is imported as the
+# coordinates, or the place when the tweet has no coordinates, without
+# display_coordinates.
+terraform import twitter_tweet.tweet 1559537820804399104
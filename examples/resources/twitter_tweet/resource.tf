@@ -1,3 +1,52 @@
 resource "twitter_tweet" "tweet" {
   text = "Hello from my Terraform provider"
 }
+
+resource "twitter_tweet" "reply" {
+  text                         = "Replying to my own Tweet"
+  in_reply_to_status_id        = twitter_tweet.tweet.id
+  auto_populate_reply_metadata = true
+}
+
+resource "twitter_tweet" "quote" {
+  text           = "Quoting my own Tweet"
+  quote_tweet_id = twitter_tweet.tweet.id
+}
+
+resource "twitter_tweet" "media" {
+  text = "Tweet with an image"
+
+  media {
+    file     = "${path.module}/image.png"
+    alt_text = "Terraform logo"
+  }
+}
+
+resource "twitter_tweet" "location" {
+  text = "Tweeting from San Francisco"
+
+  location {
+    latitude            = 37.7821
+    longitude           = -122.4093
+    display_coordinates = true
+  }
+}
+
+resource "twitter_tweet" "poll" {
+  text = "Which provider should we write next?"
+
+  poll {
+    options          = ["Mastodon", "Bluesky", "Threads"]
+    duration_minutes = 1440
+  }
+}
+
+resource "twitter_tweet" "announcement" {
+  text           = "Replies are limited to the people we follow"
+  reply_settings = "following"
+}
+
+resource "twitter_tweet" "editable" {
+  text          = "Typos in this Tweet are fixed with an edit"
+  edit_strategy = "edit"
+}
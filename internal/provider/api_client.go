@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Base URLs of the Twitter API endpoints that are not wrapped by go-twitter.
+const (
+	twitterAPIv1 = "https://api.twitter.com/1.1/"
+	twitterAPIv2 = "https://api.twitter.com/2/"
+)
+
+// apiError is returned by doAPIRequest when the API responds with a non 2xx
+// status code.
+type apiError struct {
+	StatusCode int
+	Status     string
+	Body       string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Status, e.Body)
+}
+
+// isNotFound reports whether err is an apiError with a 404 status code.
+func isNotFound(err error) bool {
+	apiErr, ok := err.(*apiError)
+	return ok && apiErr.StatusCode == http.StatusNotFound
+}
+
+// bearerTransport authenticates every request with an OAuth 2.0 access token.
+type bearerTransport struct {
+	token string
+	base  http.RoundTripper
+}
+
+func (t *bearerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+t.token)
+
+	return t.base.RoundTrip(req)
+}
+
+// doAPIRequest sends req with client, usually the OAuth1 client of the
+// provider, and decodes the JSON response into v, if v is not nil.
+func doAPIRequest(client *http.Client, req *http.Request, v interface{}) error {
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return &apiError{
+			StatusCode: res.StatusCode,
+			Status:     res.Status,
+			Body:       string(body),
+		}
+	}
+
+	if v == nil || len(body) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(body, v)
+}
@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var _ tfsdk.ResourceType = blockResourceType{}
+
+// https://developer.twitter.com/en/docs/twitter-api/v1/accounts-and-users/mute-block-report-users/api-reference/post-blocks-create
+var blockRelationship = userRelationship{
+	Name:        "block",
+	Connection:  "blocking",
+	CreatePath:  "blocks/create.json",
+	DestroyPath: "blocks/destroy.json",
+}
+
+type blockResourceType struct{}
+
+func (t blockResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return userRelationshipSchema("Blocks a user for the authenticating user. Blocking a user also unfollows them."), nil
+}
+
+func (t blockResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return userRelationshipResource{
+		provider:     provider,
+		relationship: blockRelationship,
+	}, diags
+}
@@ -0,0 +1,355 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sebastianmarines/terraform-provider-twitter/internal/utils"
+)
+
+var _ tfsdk.ResourceType = bookmarkResourceType{}
+var _ tfsdk.Resource = bookmarkResource{}
+var _ tfsdk.ResourceWithImportState = bookmarkResource{}
+
+type bookmarkResourceType struct{}
+
+func (t bookmarkResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Bookmarks a Tweet through the v2 API. The bookmarks endpoints only accept an OAuth 2.0 user context token, so `oauth2_access_token` must be set in the provider configuration. The Tweet is bookmarked for the user the token belongs to.",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "The ID of the bookmarked Tweet.",
+				Type:                types.Int64Type,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"tweet_id": {
+				MarkdownDescription: "The ID of the Tweet to bookmark.",
+				Type:                types.Int64Type,
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t bookmarkResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return bookmarkResource{
+		provider: provider,
+	}, diags
+}
+
+type bookmarkResourceData struct {
+	ID      types.Int64 `tfsdk:"id"`
+	TweetID types.Int64 `tfsdk:"tweet_id"`
+}
+
+type bookmarkRequest struct {
+	TweetID string `json:"tweet_id"`
+}
+
+type bookmarksResponse struct {
+	Data []struct {
+		ID string `json:"id"`
+	} `json:"data"`
+	Meta struct {
+		NextToken string `json:"next_token"`
+	} `json:"meta"`
+}
+
+type usersMeResponse struct {
+	Data struct {
+		ID string `json:"id"`
+	} `json:"data"`
+}
+
+type bookmarkResource struct {
+	provider provider
+}
+
+func (t bookmarkResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, t.provider.configured)
+	if err != nil {
+		return
+	}
+
+	userID, err := t.bookmarksUserID(ctx, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+
+	var data bookmarkResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err = t.createBookmark(ctx, userID, data.TweetID.Value)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not bookmark tweet",
+			fmt.Sprintf("Unable to bookmark tweet %d, got error %s", data.TweetID.Value, err),
+		)
+		return
+	}
+
+	data.ID = data.TweetID
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r bookmarkResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, r.provider.configured)
+	if err != nil {
+		return
+	}
+
+	userID, err := r.bookmarksUserID(ctx, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+
+	var data bookmarkResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bookmarked, err := r.isBookmarked(ctx, userID, data.TweetID.Value)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read bookmark",
+			fmt.Sprintf("Unable to read bookmarks, got error: %s", err),
+		)
+		return
+	}
+
+	// The bookmark has been removed outside of Terraform, or the tweet no
+	// longer exists.
+	if !bookmarked {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = data.TweetID
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r bookmarkResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	resp.Diagnostics.AddError(
+		"Update not supported",
+		"Update is not supported for bookmark resource",
+	)
+	return
+}
+
+func (r bookmarkResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, r.provider.configured)
+	if err != nil {
+		return
+	}
+
+	userID, err := r.bookmarksUserID(ctx, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+
+	var data bookmarkResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err = r.deleteBookmark(ctx, userID, data.TweetID.Value)
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Could not remove bookmark",
+			fmt.Sprintf("Unable to remove bookmark of tweet %d, got error: %s", data.TweetID.Value, err),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r bookmarkResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, r.provider.configured)
+	if err != nil {
+		return
+	}
+
+	userID, err := r.bookmarksUserID(ctx, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+
+	tweetID, err := strconv.ParseInt(req.ID, 10, 64)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not import bookmark",
+			fmt.Sprintf("Tweet ID must be an integer, got: %q", req.ID),
+		)
+		return
+	}
+
+	bookmarked, err := r.isBookmarked(ctx, userID, tweetID)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not import bookmark",
+			fmt.Sprintf("Unable to read bookmarks, got error: %s", err),
+		)
+		return
+	}
+
+	if !bookmarked {
+		resp.Diagnostics.AddError(
+			"Could not import bookmark",
+			fmt.Sprintf("The authenticated user has not bookmarked tweet %d", tweetID),
+		)
+		return
+	}
+
+	data := &bookmarkResourceData{
+		ID:      types.Int64{Value: tweetID},
+		TweetID: types.Int64{Value: tweetID},
+	}
+
+	diags := resp.State.Set(ctx, data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// bookmarksUserID returns the ID of the user the OAuth 2.0 access token
+// belongs to, as the bookmarks endpoints reject OAuth 1.0a credentials.
+func (r bookmarkResource) bookmarksUserID(ctx context.Context, d *diag.Diagnostics) (int64, error) {
+	if r.provider.oauth2Client == nil {
+		d.AddError(
+			"Missing Twitter OAuth 2.0 access token",
+			"The bookmarks endpoints only accept an OAuth 2.0 user context token. Set oauth2_access_token in the provider configuration or the TWITTER_OAUTH2_ACCESS_TOKEN environment variable.",
+		)
+		return 0, errors.New("missing OAuth 2.0 access token")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, twitterAPIv2+"users/me", nil)
+
+	var res usersMeResponse
+
+	if err == nil {
+		err = doAPIRequest(r.provider.oauth2Client, req, &res)
+	}
+
+	var userID int64
+
+	if err == nil {
+		userID, err = strconv.ParseInt(res.Data.ID, 10, 64)
+	}
+
+	if err != nil {
+		d.AddError(
+			"Unable to verify Twitter OAuth 2.0 access token",
+			fmt.Sprintf("Unable to read the user of the OAuth 2.0 access token, got error %s", err),
+		)
+		return 0, err
+	}
+
+	return userID, nil
+}
+
+// bookmarksURL returns the URL of the bookmarks of the user.
+// https://developer.twitter.com/en/docs/twitter-api/tweets/bookmarks/introduction
+func (r bookmarkResource) bookmarksURL(userID int64) string {
+	return fmt.Sprintf("%susers/%d/bookmarks", twitterAPIv2, userID)
+}
+
+func (r bookmarkResource) createBookmark(ctx context.Context, userID int64, tweetID int64) error {
+	body, err := json.Marshal(bookmarkRequest{
+		TweetID: strconv.FormatInt(tweetID, 10),
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.bookmarksURL(userID), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	return doAPIRequest(r.provider.oauth2Client, req, nil)
+}
+
+func (r bookmarkResource) deleteBookmark(ctx context.Context, userID int64, tweetID int64) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, r.bookmarksURL(userID)+"/"+strconv.FormatInt(tweetID, 10), nil)
+	if err != nil {
+		return err
+	}
+
+	return doAPIRequest(r.provider.oauth2Client, req, nil)
+}
+
+// isBookmarked pages through the bookmarks of the user until
+// the given tweet is found.
+func (r bookmarkResource) isBookmarked(ctx context.Context, userID int64, tweetID int64) (bool, error) {
+	id := strconv.FormatInt(tweetID, 10)
+	query := url.Values{
+		"max_results": {"100"},
+	}
+
+	for {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.bookmarksURL(userID)+"?"+query.Encode(), nil)
+		if err != nil {
+			return false, err
+		}
+
+		var res bookmarksResponse
+
+		err = doAPIRequest(r.provider.oauth2Client, req, &res)
+		if err != nil {
+			return false, err
+		}
+
+		for _, tweet := range res.Data {
+			if tweet.ID == id {
+				return true, nil
+			}
+		}
+
+		if res.Meta.NextToken == "" {
+			return false, nil
+		}
+
+		query.Set("pagination_token", res.Meta.NextToken)
+	}
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"k8s.io/apimachinery/pkg/util/rand"
+)
+
+func TestAccBookmarkResource(t *testing.T) {
+	tweetText := rand.String(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckOAuth2(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBookmarkResourceConfig(tweetText),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("twitter_bookmark.acc", "tweet_id", "twitter_tweet.acc", "id"),
+					resource.TestCheckResourceAttrPair("twitter_bookmark.acc", "id", "twitter_tweet.acc", "id"),
+				),
+			},
+			// Import the bookmark by tweet ID
+			{
+				ResourceName:      "twitter_bookmark.acc",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccBookmarkResourceConfig(text string) string {
+	return fmt.Sprintf(`
+resource "twitter_tweet" "acc" {
+  text = %[1]q
+}
+
+resource "twitter_bookmark" "acc" {
+  tweet_id = twitter_tweet.acc.id
+}`, text)
+
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/avast/retry-go"
 	"github.com/dghubble/go-twitter/twitter"
@@ -11,16 +14,28 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/sebastianmarines/terraform-provider-twitter/internal/utils"
+	"github.com/sebastianmarines/terraform-provider-twitter/internal/validators"
 )
 
+// Values of the status attribute of a follow.
+const (
+	followStatusFollowing = "following"
+	followStatusPending   = "pending"
+)
+
+// followAcceptancePollInterval is the interval at which a pending follow
+// request is checked while waiting for it to be accepted.
+const followAcceptancePollInterval = 30 * time.Second
+
 var _ tfsdk.ResourceType = profileResourceType{}
 var _ tfsdk.Resource = profileResource{}
+var _ tfsdk.ResourceWithImportState = followResource{}
 
 type followResourceType struct{}
 
 func (t followResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
 	return tfsdk.Schema{
-		MarkdownDescription: "Allows the authenticating user to follow (friend) a user.",
+		MarkdownDescription: "Allows the authenticating user to follow (friend) a user. Following a protected user sends a follow request, which stays pending until the user accepts it. A pending follow request cannot be cancelled through the API, so destroying it only removes it from the state.",
 
 		Attributes: map[string]tfsdk.Attribute{
 			"id": {
@@ -46,6 +61,22 @@ func (t followResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.D
 					tfsdk.RequiresReplace(),
 				},
 			},
+			"status": {
+				MarkdownDescription: "Whether the user is followed (`following`) or a follow request to the protected user awaits approval (`pending`).",
+				Type:                types.StringType,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"wait_for_acceptance": {
+				MarkdownDescription: "How long to wait for a follow request to a protected user to be accepted, such as `24h`. The apply fails and the resource is tainted if the request is not accepted in time. By default the request is left pending.",
+				Type:                types.StringType,
+				Optional:            true,
+				Validators: []tfsdk.AttributeValidator{
+					validators.Duration(),
+				},
+			},
 		},
 	}, nil
 }
@@ -59,9 +90,11 @@ func (t followResourceType) NewResource(ctx context.Context, in tfsdk.Provider)
 }
 
 type followResourceData struct {
-	ID         types.Int64  `tfsdk:"id"`
-	ScreenName types.String `tfsdk:"screen_name"`
-	UserId     types.Int64  `tfsdk:"user_id"`
+	ID                types.Int64  `tfsdk:"id"`
+	ScreenName        types.String `tfsdk:"screen_name"`
+	UserId            types.Int64  `tfsdk:"user_id"`
+	Status            types.String `tfsdk:"status"`
+	WaitForAcceptance types.String `tfsdk:"wait_for_acceptance"`
 }
 
 type followResource struct {
@@ -105,12 +138,11 @@ func (t followResource) Create(ctx context.Context, req tfsdk.CreateResourceRequ
 		return
 	}
 
-	if user.Protected {
-		resp.Diagnostics.AddError(
-			"Could not follow user",
-			"Following private users is not supported",
-		)
-		return
+	// The response of friendships/create does not reflect the new
+	// relationship yet, so the status is derived from the user beforehand.
+	status := followStatusFollowing
+	if user.Protected && !user.Following {
+		status = followStatusPending
 	}
 
 	params := &twitter.FriendshipCreateParams{
@@ -138,6 +170,25 @@ func (t followResource) Create(ctx context.Context, req tfsdk.CreateResourceRequ
 	follow.ScreenName.Value = user.ScreenName
 	follow.UserId.Value = user.ID
 	follow.ID.Value = user.ID
+	follow.Status.Value = status
+	follow.WaitForAcceptance = data.WaitForAcceptance
+
+	if status == followStatusPending && !data.WaitForAcceptance.Null {
+		timeout, _ := time.ParseDuration(data.WaitForAcceptance.Value)
+
+		err = t.waitForAcceptance(ctx, user.ID, timeout)
+
+		if err != nil {
+			// The follow request has been sent, so it is kept in the
+			// state and the resource is tainted.
+			resp.Diagnostics.AddError(
+				"Follow request not accepted",
+				fmt.Sprintf("Unable to follow @%s, %s", user.ScreenName, err),
+			)
+		} else {
+			follow.Status.Value = followStatusFollowing
+		}
+	}
 
 	diags = resp.State.Set(ctx, follow)
 	resp.Diagnostics.Append(diags...)
@@ -186,17 +237,35 @@ func (r followResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest,
 	follow.ScreenName.Value = user.ScreenName
 	follow.UserId.Value = user.ID
 	follow.ID.Value = user.ID
+	follow.Status.Value = followStatus(user)
+	follow.WaitForAcceptance = data.WaitForAcceptance
 
 	diags = resp.State.Set(ctx, &follow)
 	resp.Diagnostics.Append(diags...)
 }
 
 func (r followResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
-	resp.Diagnostics.AddError(
-		"Update not supported",
-		"Update is not supported for follow resource",
-	)
-	return
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, r.provider.configured)
+	if err != nil {
+		return
+	}
+
+	var data followResourceData
+	var state followResourceData
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every other attribute requires a replacement, wait_for_acceptance
+	// only applies to the creation of the follow.
+	state.WaitForAcceptance = data.WaitForAcceptance
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r followResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
@@ -214,6 +283,18 @@ func (r followResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequ
 		return
 	}
 
+	// Unfollowing does not cancel a pending follow request and the API has no
+	// endpoint to cancel it, so a pending follow is only removed from the
+	// state.
+	if data.Status.Value == followStatusPending {
+		resp.Diagnostics.AddWarning(
+			"Follow request still pending",
+			"The follow request cannot be cancelled through the Twitter API. It has been removed from the Terraform state, but it has to be cancelled from Twitter.",
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	params := &twitter.FriendshipDestroyParams{}
 
 	if !data.ScreenName.Null {
@@ -243,8 +324,8 @@ func (r followResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequ
 				return err
 			}
 
-			if user.FollowRequestSent || user.Following {
-				return errors.New("Unable to unfollow user")
+			if user.Following {
+				return errors.New("user is still followed")
 			}
 
 			return nil
@@ -254,10 +335,103 @@ func (r followResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequ
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Could not unfollow user",
-			fmt.Sprintf("Unable to unfollow user"),
+			"Unable to unfollow user",
 		)
 		return
 	}
 
 	resp.State.RemoveResource(ctx)
 }
+
+func (r followResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, r.provider.configured)
+	if err != nil {
+		return
+	}
+
+	params := &twitter.UserShowParams{}
+
+	if strings.HasPrefix(req.ID, "@") {
+		params.ScreenName = strings.TrimPrefix(req.ID, "@")
+	} else {
+		userId, err := strconv.ParseInt(req.ID, 10, 64)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Could not import follow",
+				fmt.Sprintf("Import ID must be a numeric user ID or a screen name prefixed with @, got: %q", req.ID),
+			)
+			return
+		}
+
+		params.UserID = userId
+	}
+
+	user, _, err := r.provider.client.Users.Show(params)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not import follow",
+			fmt.Sprintf("Unable to read user %s, got error %s", req.ID, err),
+		)
+		return
+	}
+
+	if !user.FollowRequestSent && !user.Following {
+		resp.Diagnostics.AddError(
+			"Could not import follow",
+			fmt.Sprintf("The authenticated user does not follow @%s", user.ScreenName),
+		)
+		return
+	}
+
+	follow := &followResourceData{}
+	follow.ScreenName.Value = user.ScreenName
+	follow.UserId.Value = user.ID
+	follow.ID.Value = user.ID
+	follow.Status.Value = followStatus(user)
+	follow.WaitForAcceptance.Null = true
+
+	diags := resp.State.Set(ctx, follow)
+	resp.Diagnostics.Append(diags...)
+}
+
+// waitForAcceptance polls the user until the follow request is accepted. It
+// fails when the request is declined or not accepted within timeout.
+func (r followResource) waitForAcceptance(ctx context.Context, userID int64, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("the follow request was not accepted within %s", timeout)
+		case <-time.After(followAcceptancePollInterval):
+		}
+
+		user, _, err := r.provider.client.Users.Show(&twitter.UserShowParams{
+			UserID: userID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if user.Following {
+			return nil
+		}
+
+		if !user.FollowRequestSent {
+			return errors.New("the follow request was declined")
+		}
+	}
+}
+
+// followStatus returns the status of the follow of user, which must be
+// either followed or have a pending follow request.
+func followStatus(user *twitter.User) string {
+	if user.Following {
+		return followStatusFollowing
+	}
+
+	return followStatusPending
+}
@@ -1,11 +1,16 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"regexp"
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
@@ -17,12 +22,44 @@ func TestAccFollowResource(t *testing.T) {
 			// Follow a user
 			{
 				Config: testAccFollowResourceConfig("HashiCorp", -1),
-				Check:  resource.TestCheckResourceAttr("twitter_follow.acc", "user_id", "290900886"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("twitter_follow.acc", "user_id", "290900886"),
+					resource.TestCheckResourceAttr("twitter_follow.acc", "status", "following"),
+				),
 			},
-			// Test that following a private user fails
+			// Import the follow by user ID
 			{
-				Config:      testAccFollowResourceConfig("Terraformpriva1", -1),
-				ExpectError: regexp.MustCompile("Following private users is not supported"),
+				ResourceName:      "twitter_follow.acc",
+				ImportState:       true,
+				ImportStateId:     "290900886",
+				ImportStateVerify: true,
+			},
+			// Import the follow by screen name
+			{
+				ResourceName:      "twitter_follow.acc",
+				ImportState:       true,
+				ImportStateId:     "@HashiCorp",
+				ImportStateVerify: true,
+			},
+			// Following a private user sends a pending follow request
+			{
+				Config: testAccFollowResourceConfig("Terraformpriva1", -1),
+				Check:  resource.TestCheckResourceAttr("twitter_follow.acc", "status", "pending"),
+			},
+			// Destroying a pending follow removes it from the state
+			{
+				Config:  testAccFollowResourceConfig("Terraformpriva1", -1),
+				Destroy: true,
+			},
+			// Test that wait_for_acceptance must be a duration
+			{
+				Config: `
+resource "twitter_follow" "acc" {
+  screen_name         = "Terraformpriva1"
+  wait_for_acceptance = "forever"
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Duration"),
 			},
 		},
 	})
@@ -46,3 +83,70 @@ resource "twitter_follow" "acc" {
 }
 `, screenName, userIdString)
 }
+
+func TestFollowResourceDelete(t *testing.T) {
+	testCases := []struct {
+		name            string
+		status          string
+		roundTrip       roundTripFunc
+		warning         bool
+		err             bool
+		removeFromState bool
+	}{
+		{
+			name:   "pending",
+			status: followStatusPending,
+			roundTrip: func(req *http.Request) (*http.Response, error) {
+				t.Errorf("unexpected request %s %s", req.Method, req.URL)
+				return testJSONResponse(http.StatusInternalServerError, `{}`), nil
+			},
+			warning:         true,
+			removeFromState: true,
+		},
+		{
+			name:   "unfollow failure",
+			status: followStatusFollowing,
+			roundTrip: func(req *http.Request) (*http.Response, error) {
+				return testJSONResponse(http.StatusForbidden, `{"errors":[{"code":161,"message":"You are unable to follow more people at this time."}]}`), nil
+			},
+			err: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			r := followResource{provider: testProvider(tc.roundTrip)}
+
+			state := testState(t, followResourceType{}, followResourceData{
+				ID:                types.Int64{Value: 290900886},
+				ScreenName:        types.String{Value: "HashiCorp"},
+				UserId:            types.Int64{Value: 290900886},
+				Status:            types.String{Value: tc.status},
+				WaitForAcceptance: types.String{Null: true},
+			})
+
+			req := tfsdk.DeleteResourceRequest{State: state}
+			resp := &tfsdk.DeleteResourceResponse{State: state}
+
+			r.Delete(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tc.err {
+				t.Errorf("expected error to be %t, got diagnostics %v", tc.err, resp.Diagnostics)
+			}
+			hasWarning := false
+			for _, d := range resp.Diagnostics {
+				if d.Severity() == diag.SeverityWarning {
+					hasWarning = true
+				}
+			}
+			if hasWarning != tc.warning {
+				t.Errorf("expected warning to be %t, got diagnostics %v", tc.warning, resp.Diagnostics)
+			}
+			if removed := resp.State.Raw.IsNull(); removed != tc.removeFromState {
+				t.Errorf("expected removal from state to be %t", tc.removeFromState)
+			}
+		})
+	}
+}
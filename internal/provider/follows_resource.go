@@ -0,0 +1,653 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dghubble/go-twitter/twitter"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/sebastianmarines/terraform-provider-twitter/internal/utils"
+)
+
+// friendIDsPageSize is the maximum number of IDs returned by a single
+// friends/ids request.
+const friendIDsPageSize = 5000
+
+var _ tfsdk.ResourceType = followsResourceType{}
+var _ tfsdk.Resource = followsResource{}
+var _ tfsdk.ResourceWithImportState = followsResource{}
+
+type followsResourceType struct{}
+
+func (t followsResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Manages a set of users followed by the authenticating user. The following list is read once per refresh and only the users that differ are followed or unfollowed.",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "The ID of the authenticated user.",
+				Type:                types.Int64Type,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"users": {
+				MarkdownDescription: "The users to follow, identified by their screen name, optionally prefixed with `@`, or by their numeric user ID.",
+				Type: types.SetType{
+					ElemType: types.StringType,
+				},
+				Required: true,
+			},
+			"exclusive": {
+				MarkdownDescription: "Whether to unfollow the users that are followed but not declared in `users`. Users followed outside of Terraform show up in `users` by their numeric ID. Otherwise only the users in `managed_user_ids` are unfollowed when they are removed from `users` or the resource is destroyed. Defaults to `false`.",
+				Type:                types.BoolType,
+				Optional:            true,
+			},
+			"user_ids": {
+				MarkdownDescription: "The IDs of the followed users, keyed by their entry in `users`.",
+				Type: types.MapType{
+					ElemType: types.Int64Type,
+				},
+				Computed: true,
+			},
+			"managed_user_ids": {
+				MarkdownDescription: "The IDs of the users followed by this resource. Users that were already followed when they were added to `users` are not included.",
+				Type: types.SetType{
+					ElemType: types.Int64Type,
+				},
+				Computed: true,
+			},
+		},
+	}, nil
+}
+
+func (t followsResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return followsResource{
+		provider: provider,
+	}, diags
+}
+
+type followsResourceData struct {
+	ID        types.Int64 `tfsdk:"id"`
+	Users     types.Set   `tfsdk:"users"`
+	Exclusive types.Bool  `tfsdk:"exclusive"`
+	UserIDs   types.Map   `tfsdk:"user_ids"`
+	Managed   types.Set   `tfsdk:"managed_user_ids"`
+}
+
+func newFollowsResourceData(userID int64, exclusive types.Bool, userIDs map[string]int64, managed map[int64]bool) *followsResourceData {
+	data := &followsResourceData{
+		ID: types.Int64{Value: userID},
+		Users: types.Set{
+			ElemType: types.StringType,
+			Elems:    []attr.Value{},
+		},
+		Exclusive: exclusive,
+		UserIDs: types.Map{
+			ElemType: types.Int64Type,
+			Elems:    map[string]attr.Value{},
+		},
+		Managed: types.Set{
+			ElemType: types.Int64Type,
+			Elems:    []attr.Value{},
+		},
+	}
+
+	for identifier, id := range userIDs {
+		data.Users.Elems = append(data.Users.Elems, types.String{Value: identifier})
+		data.UserIDs.Elems[identifier] = types.Int64{Value: id}
+	}
+
+	for _, id := range sortedUserIDs(managed) {
+		data.Managed.Elems = append(data.Managed.Elems, types.Int64{Value: id})
+	}
+
+	return data
+}
+
+// managedUserIDs returns the IDs in the managed_user_ids attribute.
+func (d *followsResourceData) managedUserIDs(ctx context.Context, diags *diag.Diagnostics) map[int64]bool {
+	var ids []int64
+
+	diags.Append(d.Managed.ElementsAs(ctx, &ids, false)...)
+
+	managed := make(map[int64]bool, len(ids))
+	for _, id := range ids {
+		managed[id] = true
+	}
+
+	return managed
+}
+
+type followsResource struct {
+	provider provider
+}
+
+func (t followsResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, t.provider.configured)
+	if err != nil {
+		return
+	}
+
+	userID, err := t.provider.authenticatedUserID(&resp.Diagnostics)
+	if err != nil {
+		return
+	}
+
+	var data followsResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userIDs, managed := t.sync(ctx, userID, &data, nil, nil, &resp.Diagnostics)
+
+	if userIDs == nil {
+		return
+	}
+
+	diags = resp.State.Set(ctx, newFollowsResourceData(userID, data.Exclusive, userIDs, managed))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r followsResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, r.provider.configured)
+	if err != nil {
+		return
+	}
+
+	userID, err := r.provider.authenticatedUserID(&resp.Diagnostics)
+	if err != nil {
+		return
+	}
+
+	var data followsResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var previous map[string]int64
+
+	diags = data.UserIDs.ElementsAs(ctx, &previous, false)
+	resp.Diagnostics.Append(diags...)
+
+	managed := data.managedUserIDs(ctx, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	following, err := r.following(userID)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read follows",
+			fmt.Sprintf("Unable to read the users followed by the authenticated user, got error: %s", err),
+		)
+		return
+	}
+
+	userIDs := make(map[string]int64, len(previous))
+	declared := make(map[int64]bool, len(previous))
+
+	// Users unfollowed outside of Terraform are dropped from the state, so
+	// they are followed again on the next apply.
+	for identifier, id := range previous {
+		declared[id] = true
+
+		if following[id] {
+			userIDs[identifier] = id
+		}
+	}
+
+	for id := range managed {
+		if !following[id] {
+			delete(managed, id)
+		}
+	}
+
+	if data.Exclusive.Value {
+		for id := range following {
+			if !declared[id] {
+				userIDs[strconv.FormatInt(id, 10)] = id
+			}
+		}
+	}
+
+	diags = resp.State.Set(ctx, newFollowsResourceData(userID, data.Exclusive, userIDs, managed))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r followsResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, r.provider.configured)
+	if err != nil {
+		return
+	}
+
+	userID, err := r.provider.authenticatedUserID(&resp.Diagnostics)
+	if err != nil {
+		return
+	}
+
+	var data followsResourceData
+	var state followsResourceData
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var previous map[string]int64
+
+	diags := state.UserIDs.ElementsAs(ctx, &previous, false)
+	resp.Diagnostics.Append(diags...)
+
+	managed := state.managedUserIDs(ctx, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The state is saved even when some users could not be followed or
+	// unfollowed, so that it reflects the changes that were made.
+	userIDs, managed := r.sync(ctx, userID, &data, previous, managed, &resp.Diagnostics)
+
+	if userIDs == nil {
+		return
+	}
+
+	diags = resp.State.Set(ctx, newFollowsResourceData(userID, data.Exclusive, userIDs, managed))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r followsResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, r.provider.configured)
+	if err != nil {
+		return
+	}
+
+	var data followsResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var userIDs map[string]int64
+
+	diags = data.UserIDs.ElementsAs(ctx, &userIDs, false)
+	resp.Diagnostics.Append(diags...)
+
+	managed := data.managedUserIDs(ctx, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Without exclusive, the users that were already followed before they
+	// were added to users stay followed.
+	unfollowed := managed
+
+	if data.Exclusive.Value {
+		unfollowed = make(map[int64]bool, len(userIDs))
+
+		for _, id := range userIDs {
+			unfollowed[id] = true
+		}
+	}
+
+	failed, errs := r.unfollow(unfollowed)
+
+	if len(errs) > 0 {
+		// Only the users that could not be unfollowed are kept in the
+		// state, so that they are unfollowed again on the next destroy.
+		remaining := make(map[string]int64, len(failed))
+
+		for identifier, id := range userIDs {
+			if failed[id] {
+				remaining[identifier] = id
+			}
+		}
+
+		for id := range managed {
+			if !failed[id] {
+				delete(managed, id)
+			}
+		}
+
+		diags = resp.State.Set(ctx, newFollowsResourceData(data.ID.Value, data.Exclusive, remaining, managed))
+		resp.Diagnostics.Append(diags...)
+
+		resp.Diagnostics.AddError(
+			"Could not unfollow users",
+			fmt.Sprintf("Unable to unfollow the following users:\n%s", strings.Join(errs, "\n")),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r followsResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, r.provider.configured)
+	if err != nil {
+		return
+	}
+
+	userID, err := r.provider.authenticatedUserID(&resp.Diagnostics)
+	if err != nil {
+		return
+	}
+
+	if req.ID != "me" {
+		userId, err := strconv.ParseInt(req.ID, 10, 64)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Could not import follows",
+				fmt.Sprintf("Import ID must be \"me\" or the numeric ID of the authenticated user, got: %q", req.ID),
+			)
+			return
+		}
+
+		if userId != userID {
+			resp.Diagnostics.AddError(
+				"Could not import follows",
+				fmt.Sprintf("User ID %d does not belong to the authenticated user (ID %d)", userId, userID),
+			)
+			return
+		}
+	}
+
+	following, err := r.following(userID)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not import follows",
+			fmt.Sprintf("Unable to read the users followed by the authenticated user, got error: %s", err),
+		)
+		return
+	}
+
+	userIDs := make(map[string]int64, len(following))
+
+	for id := range following {
+		userIDs[strconv.FormatInt(id, 10)] = id
+	}
+
+	// The users followed before the import were not followed by this
+	// resource, so they are not unfollowed unless exclusive is set.
+	diags := resp.State.Set(ctx, newFollowsResourceData(userID, types.Bool{Null: true}, userIDs, nil))
+	resp.Diagnostics.Append(diags...)
+}
+
+// sync resolves the planned users and follows or unfollows only the users
+// that differ from the current following list. previous holds the users
+// declared so far, which don't need to be resolved again, and managed the
+// users followed by this resource, which are unfollowed when they are no
+// longer planned. It returns the IDs of the followed users, keyed by their
+// entry in users, and the IDs of the users managed by this resource. Both are
+// nil when nothing was changed because of an error.
+func (r followsResource) sync(ctx context.Context, userID int64, data *followsResourceData, previous map[string]int64, managed map[int64]bool, diags *diag.Diagnostics) (map[string]int64, map[int64]bool) {
+	var configured []string
+
+	diags.Append(data.Users.ElementsAs(ctx, &configured, false)...)
+
+	if diags.HasError() {
+		return nil, nil
+	}
+
+	planned := make(map[string]int64, len(configured))
+	var unresolved []string
+
+	for _, identifier := range configured {
+		if id, ok := previous[identifier]; ok {
+			planned[identifier] = id
+		} else {
+			unresolved = append(unresolved, identifier)
+		}
+	}
+
+	resolved, err := lookupUsers(&r.provider.client, unresolved)
+
+	if err != nil {
+		diags.AddError(
+			"Could not resolve users",
+			fmt.Sprintf("Unable to look up the users to follow, got error: %s", err),
+		)
+		return nil, nil
+	}
+
+	for _, identifier := range unresolved {
+		user, ok := resolved[identifier]
+
+		if !ok {
+			diags.AddAttributeError(
+				tftypes.NewAttributePath().WithAttributeName("users").WithElementKeyValue(tftypes.NewValue(tftypes.String, identifier)),
+				"User not found",
+				fmt.Sprintf("No user matches %q, it must be an existing screen name or numeric user ID.", identifier),
+			)
+			continue
+		}
+
+		planned[identifier] = user.ID
+	}
+
+	if diags.HasError() {
+		return nil, nil
+	}
+
+	following, err := r.following(userID)
+
+	if err != nil {
+		diags.AddError(
+			"Could not read follows",
+			fmt.Sprintf("Unable to read the users followed by the authenticated user, got error: %s", err),
+		)
+		return nil, nil
+	}
+
+	desired := make(map[int64]bool, len(planned))
+	for _, id := range planned {
+		desired[id] = true
+	}
+
+	stillManaged := make(map[int64]bool, len(managed))
+	for id := range managed {
+		if following[id] {
+			stillManaged[id] = true
+		}
+	}
+
+	// Without exclusive, only the users that were followed by this resource
+	// are unfollowed.
+	unfollowed := make(map[int64]bool)
+
+	if data.Exclusive.Value {
+		for id := range following {
+			if !desired[id] {
+				unfollowed[id] = true
+			}
+		}
+	} else {
+		for id := range stillManaged {
+			if !desired[id] {
+				unfollowed[id] = true
+			}
+		}
+	}
+
+	failed, errs := r.unfollow(unfollowed)
+
+	if len(errs) > 0 {
+		diags.AddError(
+			"Could not unfollow users",
+			fmt.Sprintf("Unable to unfollow the following users:\n%s", strings.Join(errs, "\n")),
+		)
+	}
+
+	userIDs := make(map[string]int64, len(planned))
+
+	// The users that could not be unfollowed are kept in the state, so that
+	// they are unfollowed again on the next apply.
+	for id := range unfollowed {
+		if !failed[id] {
+			delete(stillManaged, id)
+		}
+	}
+
+	for identifier, id := range previous {
+		if failed[id] {
+			userIDs[identifier] = id
+			delete(failed, id)
+		}
+	}
+
+	for id := range failed {
+		userIDs[strconv.FormatInt(id, 10)] = id
+	}
+
+	followed := make(map[int64]bool, len(desired))
+	errs = nil
+
+	for _, id := range sortedUserIDs(desired) {
+		if following[id] {
+			followed[id] = true
+			continue
+		}
+
+		_, _, err := r.provider.client.Friendships.Create(&twitter.FriendshipCreateParams{
+			UserID: id,
+		})
+
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("- %d: %s", id, err))
+			continue
+		}
+
+		followed[id] = true
+		stillManaged[id] = true
+	}
+
+	if len(errs) > 0 {
+		diags.AddError(
+			"Could not follow users",
+			fmt.Sprintf("Unable to follow the following users:\n%s", strings.Join(errs, "\n")),
+		)
+	}
+
+	for identifier, id := range planned {
+		if followed[id] {
+			userIDs[identifier] = id
+		}
+	}
+
+	return userIDs, stillManaged
+}
+
+// unfollow unfollows the given users. It returns the IDs of the users that
+// could not be unfollowed along with a description of every error. Users that
+// no longer exist are ignored.
+func (r followsResource) unfollow(userIDs map[int64]bool) (map[int64]bool, []string) {
+	failed := make(map[int64]bool)
+	var errs []string
+
+	for _, id := range sortedUserIDs(userIDs) {
+		_, response, err := r.provider.client.Friendships.Destroy(&twitter.FriendshipDestroyParams{
+			UserID: id,
+		})
+
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				continue
+			}
+			failed[id] = true
+			errs = append(errs, fmt.Sprintf("- %d: %s", id, err))
+		}
+	}
+
+	return failed, errs
+}
+
+// following returns the IDs of the users followed by the authenticated user,
+// including the protected users that have a pending follow request.
+func (r followsResource) following(userID int64) (map[int64]bool, error) {
+	following := make(map[int64]bool)
+
+	params := &twitter.FriendIDParams{
+		UserID: userID,
+		Cursor: -1,
+		Count:  friendIDsPageSize,
+	}
+
+	for {
+		ids, _, err := r.provider.client.Friends.IDs(params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, id := range ids.IDs {
+			following[id] = true
+		}
+
+		if ids.NextCursor == 0 {
+			break
+		}
+		params.Cursor = ids.NextCursor
+	}
+
+	pendingParams := &twitter.FriendshipPendingParams{
+		Cursor: -1,
+	}
+
+	for {
+		ids, _, err := r.provider.client.Friendships.Outgoing(pendingParams)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, id := range ids.IDs {
+			following[id] = true
+		}
+
+		if ids.NextCursor == 0 {
+			break
+		}
+		pendingParams.Cursor = ids.NextCursor
+	}
+
+	return following, nil
+}
+
+func sortedUserIDs(userIDs map[int64]bool) []int64 {
+	sorted := make([]int64, 0, len(userIDs))
+
+	for id := range userIDs {
+		sorted = append(sorted, id)
+	}
+
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	return sorted
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccFollowsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Follow a set of users
+			{
+				Config: testAccFollowsResourceConfig(`"HashiCorp", "@TwitterAPI"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("twitter_follows.acc", "users.#", "2"),
+					resource.TestCheckResourceAttr("twitter_follows.acc", "user_ids.HashiCorp", "290900886"),
+					resource.TestCheckResourceAttr("twitter_follows.acc", "user_ids.@TwitterAPI", "6253282"),
+					resource.TestCheckResourceAttr("twitter_follows.acc", "managed_user_ids.#", "2"),
+				),
+			},
+			// Removing a user from the set unfollows only that user
+			{
+				Config: testAccFollowsResourceConfig(`"290900886"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("twitter_follows.acc", "users.#", "1"),
+					resource.TestCheckResourceAttr("twitter_follows.acc", "user_ids.290900886", "290900886"),
+					resource.TestCheckTypeSetElemAttr("twitter_follows.acc", "managed_user_ids.*", "290900886"),
+				),
+			},
+			// Import the following list of the authenticated user, whose
+			// users are not managed by the resource
+			{
+				ResourceName:  "twitter_follows.acc",
+				ImportState:   true,
+				ImportStateId: "me",
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if managed := states[0].Attributes["managed_user_ids.#"]; managed != "0" {
+						return fmt.Errorf("expected no managed users after import, got %s", managed)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func testAccFollowsResourceConfig(users string) string {
+	return fmt.Sprintf(`
+resource "twitter_follows" "acc" {
+  users = [%[1]s]
+}`, users)
+
+}
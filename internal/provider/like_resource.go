@@ -0,0 +1,231 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/dghubble/go-twitter/twitter"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sebastianmarines/terraform-provider-twitter/internal/utils"
+)
+
+var _ tfsdk.ResourceType = likeResourceType{}
+var _ tfsdk.Resource = likeResource{}
+var _ tfsdk.ResourceWithImportState = likeResource{}
+
+type likeResourceType struct{}
+
+func (t likeResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Likes (favorites) a Tweet as the authenticating user.",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "The ID of the liked Tweet.",
+				Type:                types.Int64Type,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"tweet_id": {
+				MarkdownDescription: "The ID of the Tweet to like.",
+				Type:                types.Int64Type,
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t likeResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return likeResource{
+		provider: provider,
+	}, diags
+}
+
+type likeResourceData struct {
+	ID      types.Int64 `tfsdk:"id"`
+	TweetID types.Int64 `tfsdk:"tweet_id"`
+}
+
+type likeResource struct {
+	provider provider
+}
+
+func (t likeResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, t.provider.configured)
+	if err != nil {
+		return
+	}
+
+	var data likeResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tweet, _, err := t.provider.client.Favorites.Create(&twitter.FavoriteCreateParams{
+		ID: data.TweetID.Value,
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not like tweet",
+			fmt.Sprintf("Unable to like tweet %d, got error %s", data.TweetID.Value, err),
+		)
+		return
+	}
+
+	data.ID = types.Int64{Value: tweet.ID}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r likeResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, r.provider.configured)
+	if err != nil {
+		return
+	}
+
+	var data likeResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &twitter.StatusShowParams{
+		ID:               data.TweetID.Value,
+		TrimUser:         twitter.Bool(true),
+		IncludeMyRetweet: twitter.Bool(false),
+		IncludeEntities:  twitter.Bool(false),
+	}
+
+	tweet, response, err := r.provider.client.Statuses.Show(data.TweetID.Value, params)
+
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError(
+				"Could not read like",
+				fmt.Sprintf("Unable to read tweet %d, got error: %s", data.TweetID.Value, err),
+			)
+			return
+		}
+	}
+
+	// The tweet has been unliked outside of Terraform.
+	if !tweet.Favorited {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.Int64{Value: tweet.ID}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r likeResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	resp.Diagnostics.AddError(
+		"Update not supported",
+		"Update is not supported for like resource",
+	)
+	return
+}
+
+func (r likeResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, r.provider.configured)
+	if err != nil {
+		return
+	}
+
+	var data likeResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, response, err := r.provider.client.Favorites.Destroy(&twitter.FavoriteDestroyParams{
+		ID: data.TweetID.Value,
+	})
+
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		resp.Diagnostics.AddError(
+			"Could not unlike tweet",
+			fmt.Sprintf("Unable to unlike tweet %d, got error: %s", data.TweetID.Value, err),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r likeResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, r.provider.configured)
+	if err != nil {
+		return
+	}
+
+	tweetID, err := strconv.ParseInt(req.ID, 10, 64)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not import like",
+			fmt.Sprintf("Tweet ID must be an integer, got: %q", req.ID),
+		)
+		return
+	}
+
+	params := &twitter.StatusShowParams{
+		ID:               tweetID,
+		TrimUser:         twitter.Bool(true),
+		IncludeMyRetweet: twitter.Bool(false),
+		IncludeEntities:  twitter.Bool(false),
+	}
+
+	tweet, _, err := r.provider.client.Statuses.Show(tweetID, params)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not import like",
+			fmt.Sprintf("Unable to read tweet with ID %d, got error: %s", tweetID, err),
+		)
+		return
+	}
+
+	if !tweet.Favorited {
+		resp.Diagnostics.AddError(
+			"Could not import like",
+			fmt.Sprintf("The authenticated user has not liked tweet %d", tweetID),
+		)
+		return
+	}
+
+	data := &likeResourceData{
+		ID:      types.Int64{Value: tweet.ID},
+		TweetID: types.Int64{Value: tweet.ID},
+	}
+
+	diags := resp.State.Set(ctx, data)
+	resp.Diagnostics.Append(diags...)
+}
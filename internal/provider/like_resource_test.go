@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"k8s.io/apimachinery/pkg/util/rand"
+)
+
+func TestAccLikeResource(t *testing.T) {
+	tweetText := rand.String(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLikeResourceConfig(tweetText),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("twitter_like.acc", "tweet_id", "twitter_tweet.acc", "id"),
+					resource.TestCheckResourceAttrPair("twitter_like.acc", "id", "twitter_tweet.acc", "id"),
+				),
+			},
+			// Import the like by tweet ID
+			{
+				ResourceName:      "twitter_like.acc",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccLikeResourceConfig(text string) string {
+	return fmt.Sprintf(`
+resource "twitter_tweet" "acc" {
+  text = %[1]q
+}
+
+resource "twitter_like" "acc" {
+  tweet_id = twitter_tweet.acc.id
+}`, text)
+
+}
@@ -0,0 +1,422 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/dghubble/go-twitter/twitter"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/sebastianmarines/terraform-provider-twitter/internal/utils"
+)
+
+// listMembersBatchSize is the maximum number of users that can be added or
+// removed with a single members/create_all or members/destroy_all request.
+const listMembersBatchSize = 100
+
+// listMembersPageSize is the maximum number of members returned by a single
+// lists/members request.
+const listMembersPageSize = 5000
+
+var _ tfsdk.ResourceType = listMembersResourceType{}
+var _ tfsdk.Resource = listMembersResource{}
+var _ tfsdk.ResourceWithImportState = listMembersResource{}
+
+type listMembersResourceType struct{}
+
+func (t listMembersResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Manages the members of a List owned by the authenticating user. Users that are members of the List but not declared in this resource are removed from it.",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "The ID of the List.",
+				Type:                types.Int64Type,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"list_id": {
+				MarkdownDescription: "The ID of the List.",
+				Type:                types.Int64Type,
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"members": {
+				MarkdownDescription: "The members of the List, identified by their screen name, optionally prefixed with `@`, or by their numeric user ID.",
+				Type: types.SetType{
+					ElemType: types.StringType,
+				},
+				Required: true,
+			},
+			"member_ids": {
+				MarkdownDescription: "The IDs of the members of the List.",
+				Type: types.SetType{
+					ElemType: types.Int64Type,
+				},
+				Computed: true,
+			},
+		},
+	}, nil
+}
+
+func (t listMembersResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return listMembersResource{
+		provider: provider,
+	}, diags
+}
+
+type listMembersResourceData struct {
+	ID        types.Int64 `tfsdk:"id"`
+	ListID    types.Int64 `tfsdk:"list_id"`
+	Members   types.Set   `tfsdk:"members"`
+	MemberIDs types.Set   `tfsdk:"member_ids"`
+}
+
+func newListMembersResourceData(listID int64, users []twitter.User, configured []string) *listMembersResourceData {
+	data := &listMembersResourceData{
+		ID:     types.Int64{Value: listID},
+		ListID: types.Int64{Value: listID},
+		Members: types.Set{
+			ElemType: types.StringType,
+			Elems:    []attr.Value{},
+		},
+		MemberIDs: types.Set{
+			ElemType: types.Int64Type,
+			Elems:    []attr.Value{},
+		},
+	}
+
+	for _, identifier := range userIdentifiers(users, configured) {
+		data.Members.Elems = append(data.Members.Elems, types.String{Value: identifier})
+	}
+
+	for _, user := range users {
+		data.MemberIDs.Elems = append(data.MemberIDs.Elems, types.Int64{Value: user.ID})
+	}
+
+	return data
+}
+
+type listMembersResource struct {
+	provider provider
+}
+
+func (t listMembersResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, t.provider.configured)
+	if err != nil {
+		return
+	}
+
+	var data listMembersResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members := t.sync(ctx, &data, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, members)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r listMembersResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, r.provider.configured)
+	if err != nil {
+		return
+	}
+
+	var data listMembersResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var configured []string
+
+	diags = data.Members.ElementsAs(ctx, &configured, false)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users, response, err := r.members(data.ListID.Value)
+
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Could not read list members",
+			fmt.Sprintf("Unable to read the members of list %d, got error: %s", data.ListID.Value, err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, newListMembersResourceData(data.ListID.Value, users, configured))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r listMembersResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, r.provider.configured)
+	if err != nil {
+		return
+	}
+
+	var data listMembersResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members := r.sync(ctx, &data, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, members)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r listMembersResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, r.provider.configured)
+	if err != nil {
+		return
+	}
+
+	var data listMembersResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var memberIDs []int64
+
+	diags = data.MemberIDs.ElementsAs(ctx, &memberIDs, false)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, batch := range userIDBatches(memberIDs, listMembersBatchSize) {
+		response, err := r.provider.client.Lists.MembersDestroyAll(&twitter.ListsMembersDestroyAllParams{
+			ListID: data.ListID.Value,
+			UserID: batch,
+		})
+
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				break
+			}
+
+			resp.Diagnostics.AddError(
+				"Could not delete list members",
+				fmt.Sprintf("Unable to remove members from list %d, got error: %s", data.ListID.Value, err),
+			)
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r listMembersResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, r.provider.configured)
+	if err != nil {
+		return
+	}
+
+	listID, err := strconv.ParseInt(req.ID, 10, 64)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not import list members",
+			fmt.Sprintf("List ID must be an integer, got: %q", req.ID),
+		)
+		return
+	}
+
+	users, _, err := r.members(listID)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not import list members",
+			fmt.Sprintf("Unable to read the members of list %d, got error: %s", listID, err),
+		)
+		return
+	}
+
+	diags := resp.State.Set(ctx, newListMembersResourceData(listID, users, nil))
+	resp.Diagnostics.Append(diags...)
+}
+
+// sync resolves the planned members and adds or removes only the users that
+// differ from the current members of the List. Members that cannot be
+// resolved are reported on their element of the set.
+func (r listMembersResource) sync(ctx context.Context, data *listMembersResourceData, diags *diag.Diagnostics) *listMembersResourceData {
+	var configured []string
+
+	diags.Append(data.Members.ElementsAs(ctx, &configured, false)...)
+
+	if diags.HasError() {
+		return nil
+	}
+
+	resolved, err := lookupUsers(&r.provider.client, configured)
+
+	if err != nil {
+		diags.AddError(
+			"Could not resolve list members",
+			fmt.Sprintf("Unable to look up the members of list %d, got error: %s", data.ListID.Value, err),
+		)
+		return nil
+	}
+
+	desired := make(map[int64]twitter.User, len(resolved))
+
+	for _, identifier := range configured {
+		user, ok := resolved[identifier]
+
+		if !ok {
+			diags.AddAttributeError(
+				tftypes.NewAttributePath().WithAttributeName("members").WithElementKeyValue(tftypes.NewValue(tftypes.String, identifier)),
+				"User not found",
+				fmt.Sprintf("No user matches %q, it must be an existing screen name or numeric user ID.", identifier),
+			)
+			continue
+		}
+
+		desired[user.ID] = user
+	}
+
+	if diags.HasError() {
+		return nil
+	}
+
+	current, _, err := r.members(data.ListID.Value)
+
+	if err != nil {
+		diags.AddError(
+			"Could not read list members",
+			fmt.Sprintf("Unable to read the members of list %d, got error: %s", data.ListID.Value, err),
+		)
+		return nil
+	}
+
+	var added, removed []int64
+	isMember := make(map[int64]bool, len(current))
+
+	for _, user := range current {
+		isMember[user.ID] = true
+
+		if _, ok := desired[user.ID]; !ok {
+			removed = append(removed, user.ID)
+		}
+	}
+
+	for userID := range desired {
+		if !isMember[userID] {
+			added = append(added, userID)
+		}
+	}
+
+	for _, batch := range userIDBatches(added, listMembersBatchSize) {
+		_, err := r.provider.client.Lists.MembersCreateAll(&twitter.ListsMembersCreateAllParams{
+			ListID: data.ListID.Value,
+			UserID: batch,
+		})
+
+		if err != nil {
+			diags.AddError(
+				"Could not add list members",
+				fmt.Sprintf("Unable to add members to list %d, got error: %s", data.ListID.Value, err),
+			)
+			return nil
+		}
+	}
+
+	for _, batch := range userIDBatches(removed, listMembersBatchSize) {
+		_, err := r.provider.client.Lists.MembersDestroyAll(&twitter.ListsMembersDestroyAllParams{
+			ListID: data.ListID.Value,
+			UserID: batch,
+		})
+
+		if err != nil {
+			diags.AddError(
+				"Could not remove list members",
+				fmt.Sprintf("Unable to remove members from list %d, got error: %s", data.ListID.Value, err),
+			)
+			return nil
+		}
+	}
+
+	// The members are not read back, as they may take a while to show up in
+	// lists/members.
+	users := make([]twitter.User, 0, len(desired))
+	for _, user := range desired {
+		users = append(users, user)
+	}
+
+	return newListMembersResourceData(data.ListID.Value, users, configured)
+}
+
+// members returns all the members of the List, following the cursors of
+// lists/members.
+func (r listMembersResource) members(listID int64) ([]twitter.User, *http.Response, error) {
+	var users []twitter.User
+
+	params := &twitter.ListsMembersParams{
+		ListID:          listID,
+		Count:           listMembersPageSize,
+		Cursor:          -1,
+		IncludeEntities: twitter.Bool(false),
+		SkipStatus:      twitter.Bool(true),
+	}
+
+	for {
+		members, response, err := r.provider.client.Lists.Members(params)
+
+		if err != nil {
+			return nil, response, err
+		}
+
+		users = append(users, members.Users...)
+
+		if members.NextCursor == 0 {
+			return users, response, nil
+		}
+
+		params.Cursor = members.NextCursor
+	}
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"k8s.io/apimachinery/pkg/util/rand"
+)
+
+func TestAccListMembersResource(t *testing.T) {
+	name := "acc-" + rand.String(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccListMembersResourceConfig(name, `"twitter", "@TwitterDev"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("twitter_list_members.acc", "list_id", "twitter_list.acc", "id"),
+					resource.TestCheckResourceAttr("twitter_list_members.acc", "members.#", "2"),
+					resource.TestCheckResourceAttr("twitter_list_members.acc", "member_ids.#", "2"),
+				),
+			},
+			// Only the difference is applied, 783214 is the ID of @twitter so a
+			// single member remains
+			{
+				Config: testAccListMembersResourceConfig(name, `"twitter", "783214"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("twitter_list_members.acc", "members.*", "783214"),
+					resource.TestCheckResourceAttr("twitter_list_members.acc", "member_ids.#", "1"),
+				),
+			},
+			// Import by list ID
+			{
+				ResourceName:            "twitter_list_members.acc",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"members"},
+			},
+		},
+	})
+}
+
+func TestAccListMembersResource_UnknownUser(t *testing.T) {
+	name := "acc-" + rand.String(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccListMembersResourceConfig(name, `"twitter", "`+rand.String(15)+`"`),
+				ExpectError: regexp.MustCompile("User not found"),
+			},
+		},
+	})
+}
+
+func testAccListMembersResourceConfig(name string, members string) string {
+	return fmt.Sprintf(`
+resource "twitter_list" "acc" {
+  name = %[1]q
+}
+
+resource "twitter_list_members" "acc" {
+  list_id = twitter_list.acc.id
+  members = [%[2]s]
+}`, name, members)
+
+}
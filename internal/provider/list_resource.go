@@ -0,0 +1,328 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/dghubble/go-twitter/twitter"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sebastianmarines/terraform-provider-twitter/internal/utils"
+	"github.com/sebastianmarines/terraform-provider-twitter/internal/validators"
+)
+
+var _ tfsdk.ResourceType = listResourceType{}
+var _ tfsdk.Resource = listResource{}
+var _ tfsdk.ResourceWithImportState = listResource{}
+
+type listResourceType struct{}
+
+func (t listResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Creates a List owned by the authenticating user.",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "The integer representation of the unique identifier for this List.",
+				Type:                types.Int64Type,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"name": {
+				MarkdownDescription: "The name of the List. Must not exceed 25 characters.",
+				Type:                types.StringType,
+				Required:            true,
+				Validators: []tfsdk.AttributeValidator{
+					validators.StringLength(1, 25),
+				},
+			},
+			"description": {
+				MarkdownDescription: "The description of the List. Must not exceed 100 characters.",
+				Type:                types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					validators.StringLength(0, 100),
+				},
+			},
+			"mode": {
+				MarkdownDescription: "Whether the List is `public` or `private`. Defaults to `public`.",
+				Type:                types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					validators.OneOf("public", "private"),
+				},
+			},
+			"slug": {
+				MarkdownDescription: "The slug of the List, derived from its name, which identifies it together with the screen name of its owner.",
+				Type:                types.StringType,
+				Computed:            true,
+			},
+		},
+	}, nil
+}
+
+func (t listResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return listResource{
+		provider: provider,
+	}, diags
+}
+
+type listResourceData struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Mode        types.String `tfsdk:"mode"`
+	Slug        types.String `tfsdk:"slug"`
+}
+
+func newListResourceData(list *twitter.List) *listResourceData {
+	return &listResourceData{
+		ID:          types.Int64{Value: list.ID},
+		Name:        types.String{Value: list.Name},
+		Description: types.String{Value: list.Description},
+		Mode:        types.String{Value: list.Mode},
+		Slug:        types.String{Value: list.Slug},
+	}
+}
+
+type listResource struct {
+	provider provider
+}
+
+func (t listResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, t.provider.configured)
+	if err != nil {
+		return
+	}
+
+	var data listResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &twitter.ListsCreateParams{
+		Name: data.Name.Value,
+	}
+
+	if !data.Description.Null && !data.Description.Unknown {
+		params.Description = data.Description.Value
+	}
+
+	if !data.Mode.Null && !data.Mode.Unknown {
+		params.Mode = data.Mode.Value
+	}
+
+	list, _, err := t.provider.client.Lists.Create(data.Name.Value, params)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not create list",
+			fmt.Sprintf("Unable to create list, got error %s", err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, newListResourceData(list))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r listResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, r.provider.configured)
+	if err != nil {
+		return
+	}
+
+	var data listResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	list, response, err := r.provider.client.Lists.Show(&twitter.ListsShowParams{
+		ListID: data.ID.Value,
+	})
+
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Could not read list",
+			fmt.Sprintf("Unable to read list %d, got error: %s", data.ID.Value, err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, newListResourceData(list))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r listResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, r.provider.configured)
+	if err != nil {
+		return
+	}
+
+	var data listResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The request is sent without go-twitter, which omits empty parameters
+	// and would make it impossible to clear the description.
+	form := url.Values{
+		"list_id": {strconv.FormatInt(data.ID.Value, 10)},
+		"name":    {data.Name.Value},
+	}
+
+	if !data.Description.Null && !data.Description.Unknown {
+		form.Set("description", data.Description.Value)
+	}
+
+	if !data.Mode.Null && !data.Mode.Unknown {
+		form.Set("mode", data.Mode.Value)
+	}
+
+	_req, err := http.NewRequestWithContext(ctx, http.MethodPost, twitterAPIv1+"lists/update.json", strings.NewReader(form.Encode()))
+
+	if err == nil {
+		_req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		err = doAPIRequest(&r.provider.httpClient, _req, nil)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not update list",
+			fmt.Sprintf("Unable to update list %d, got error %s", data.ID.Value, err),
+		)
+		return
+	}
+
+	list, _, err := r.provider.client.Lists.Show(&twitter.ListsShowParams{
+		ListID: data.ID.Value,
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read list",
+			fmt.Sprintf("Unable to read list %d, got error: %s", data.ID.Value, err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, newListResourceData(list))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r listResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, r.provider.configured)
+	if err != nil {
+		return
+	}
+
+	var data listResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, response, err := r.provider.client.Lists.Destroy(&twitter.ListsDestroyParams{
+		ListID: data.ID.Value,
+	})
+
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		resp.Diagnostics.AddError(
+			"Could not delete list",
+			fmt.Sprintf("Unable to delete list %d, got error: %s", data.ID.Value, err),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r listResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, r.provider.configured)
+	if err != nil {
+		return
+	}
+
+	params := &twitter.ListsShowParams{}
+
+	if owner, slug, ok := strings.Cut(req.ID, "/"); ok {
+		params.OwnerScreenName = strings.TrimPrefix(owner, "@")
+		params.Slug = slug
+	} else {
+		listID, err := strconv.ParseInt(req.ID, 10, 64)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Could not import list",
+				fmt.Sprintf("Import ID must be a numeric list ID or owner/slug, got: %q", req.ID),
+			)
+			return
+		}
+
+		params.ListID = listID
+	}
+
+	list, _, err := r.provider.client.Lists.Show(params)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not import list",
+			fmt.Sprintf("Unable to read list %s, got error: %s", req.ID, err),
+		)
+		return
+	}
+
+	userID, err := r.provider.authenticatedUserID(&resp.Diagnostics)
+	if err != nil {
+		return
+	}
+
+	// Lists owned by other users cannot be updated or deleted.
+	if list.User == nil || list.User.ID != userID {
+		resp.Diagnostics.AddError(
+			"Could not import list",
+			fmt.Sprintf("List %s is not owned by the authenticated user (ID %d)", req.ID, userID),
+		)
+		return
+	}
+
+	diags := resp.State.Set(ctx, newListResourceData(list))
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"k8s.io/apimachinery/pkg/util/rand"
+)
+
+func TestAccListResource(t *testing.T) {
+	name := "acc-" + rand.String(5)
+	updatedName := "acc-" + rand.String(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccListResourceConfig(name, "Created by an acceptance test", "private"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("twitter_list.acc", "name", name),
+					resource.TestCheckResourceAttr("twitter_list.acc", "description", "Created by an acceptance test"),
+					resource.TestCheckResourceAttr("twitter_list.acc", "mode", "private"),
+					resource.TestCheckResourceAttr("twitter_list.acc", "slug", name),
+					resource.TestCheckResourceAttrSet("twitter_list.acc", "id"),
+				),
+			},
+			// Import by list ID
+			{
+				ResourceName:      "twitter_list.acc",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Name, description and mode are updated in place
+			{
+				Config: testAccListResourceConfig(updatedName, "", "public"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("twitter_list.acc", "name", updatedName),
+					resource.TestCheckResourceAttr("twitter_list.acc", "description", ""),
+					resource.TestCheckResourceAttr("twitter_list.acc", "mode", "public"),
+				),
+			},
+		},
+	})
+}
+
+func testAccListResourceConfig(name string, description string, mode string) string {
+	return fmt.Sprintf(`
+resource "twitter_list" "acc" {
+  name        = %[1]q
+  description = %[2]q
+  mode        = %[3]q
+}`, name, description, mode)
+
+}
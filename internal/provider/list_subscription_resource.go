@@ -0,0 +1,243 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/dghubble/go-twitter/twitter"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sebastianmarines/terraform-provider-twitter/internal/utils"
+)
+
+var _ tfsdk.ResourceType = listSubscriptionResourceType{}
+var _ tfsdk.Resource = listSubscriptionResource{}
+var _ tfsdk.ResourceWithImportState = listSubscriptionResource{}
+
+type listSubscriptionResourceType struct{}
+
+func (t listSubscriptionResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Subscribes the authenticating user to a List.",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "The ID of the subscribed List.",
+				Type:                types.Int64Type,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"list_id": {
+				MarkdownDescription: "The ID of the List to subscribe to.",
+				Type:                types.Int64Type,
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t listSubscriptionResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return listSubscriptionResource{
+		provider: provider,
+	}, diags
+}
+
+type listSubscriptionResourceData struct {
+	ID     types.Int64 `tfsdk:"id"`
+	ListID types.Int64 `tfsdk:"list_id"`
+}
+
+type listSubscriptionResource struct {
+	provider provider
+}
+
+func (t listSubscriptionResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, t.provider.configured)
+	if err != nil {
+		return
+	}
+
+	var data listSubscriptionResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	list, _, err := t.provider.client.Lists.SubscribersCreate(&twitter.ListsSubscribersCreateParams{
+		ListID: data.ListID.Value,
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not subscribe to list",
+			fmt.Sprintf("Unable to subscribe to list %d, got error %s", data.ListID.Value, err),
+		)
+		return
+	}
+
+	data.ID = types.Int64{Value: list.ID}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r listSubscriptionResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, r.provider.configured)
+	if err != nil {
+		return
+	}
+
+	userID, err := r.provider.authenticatedUserID(&resp.Diagnostics)
+	if err != nil {
+		return
+	}
+
+	var data listSubscriptionResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subscribed, err := r.isSubscribed(userID, data.ListID.Value)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read list subscription",
+			fmt.Sprintf("Unable to read the subscription to list %d, got error: %s", data.ListID.Value, err),
+		)
+		return
+	}
+
+	// The list has been deleted or unsubscribed outside of Terraform.
+	if !subscribed {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = data.ListID
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r listSubscriptionResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	resp.Diagnostics.AddError(
+		"Update not supported",
+		"Update is not supported for list subscription resource",
+	)
+	return
+}
+
+func (r listSubscriptionResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, r.provider.configured)
+	if err != nil {
+		return
+	}
+
+	var data listSubscriptionResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.provider.client.Lists.SubscribersDestroy(&twitter.ListsSubscribersDestroyParams{
+		ListID: data.ListID.Value,
+	})
+
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		resp.Diagnostics.AddError(
+			"Could not unsubscribe from list",
+			fmt.Sprintf("Unable to unsubscribe from list %d, got error: %s", data.ListID.Value, err),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r listSubscriptionResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, r.provider.configured)
+	if err != nil {
+		return
+	}
+
+	userID, err := r.provider.authenticatedUserID(&resp.Diagnostics)
+	if err != nil {
+		return
+	}
+
+	listID, err := strconv.ParseInt(req.ID, 10, 64)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not import list subscription",
+			fmt.Sprintf("List ID must be an integer, got: %q", req.ID),
+		)
+		return
+	}
+
+	subscribed, err := r.isSubscribed(userID, listID)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not import list subscription",
+			fmt.Sprintf("Unable to read the subscription to list %d, got error: %s", listID, err),
+		)
+		return
+	}
+
+	if !subscribed {
+		resp.Diagnostics.AddError(
+			"Could not import list subscription",
+			fmt.Sprintf("The authenticated user is not subscribed to list %d", listID),
+		)
+		return
+	}
+
+	data := &listSubscriptionResourceData{
+		ID:     types.Int64{Value: listID},
+		ListID: types.Int64{Value: listID},
+	}
+
+	diags := resp.State.Set(ctx, data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// isSubscribed reports whether the authenticated user is subscribed to the
+// List. subscribers/show responds with a 404 when the user is not a
+// subscriber or the List does not exist.
+func (r listSubscriptionResource) isSubscribed(userID int64, listID int64) (bool, error) {
+	_, response, err := r.provider.client.Lists.SubscribersShow(&twitter.ListsSubscribersShowParams{
+		ListID:          listID,
+		UserID:          userID,
+		IncludeEntities: twitter.Bool(false),
+		SkipStatus:      twitter.Bool(true),
+	})
+
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// testAccPublicListID is a public List that is not owned by the test account,
+// as users cannot subscribe to their own Lists.
+const testAccPublicListID = "1130185227375038465"
+
+func TestAccListSubscriptionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccListSubscriptionResourceConfig(testAccPublicListID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("twitter_list_subscription.acc", "list_id", testAccPublicListID),
+					resource.TestCheckResourceAttr("twitter_list_subscription.acc", "id", testAccPublicListID),
+				),
+			},
+			// Import the subscription by list ID
+			{
+				ResourceName:      "twitter_list_subscription.acc",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccListSubscriptionResourceConfig(listID string) string {
+	return fmt.Sprintf(`
+resource "twitter_list_subscription" "acc" {
+  list_id = %[1]s
+}`, listID)
+
+}
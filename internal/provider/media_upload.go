@@ -0,0 +1,288 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const mediaUploadURL = "https://upload.twitter.com/1.1/media/upload.json"
+const mediaMetadataURL = "https://upload.twitter.com/1.1/media/metadata/create.json"
+
+// mediaChunkSize is the size of every APPEND request, the API accepts chunks
+// of up to 5 MB.
+const mediaChunkSize = 4 * 1024 * 1024
+
+type mediaUploadResponse struct {
+	MediaID        int64                `json:"media_id"`
+	ProcessingInfo *mediaProcessingInfo `json:"processing_info"`
+}
+
+type mediaProcessingInfo struct {
+	State          string `json:"state"`
+	CheckAfterSecs int    `json:"check_after_secs"`
+	Error          *struct {
+		Code    int    `json:"code"`
+		Name    string `json:"name"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// uploadMedia uploads the file at path using the chunked INIT, APPEND,
+// FINALIZE and STATUS flow and returns the media ID that can be attached to a
+// tweet.
+// https://developer.twitter.com/en/docs/twitter-api/v1/media/upload-media/uploading-media/chunked-media-upload
+func uploadMedia(ctx context.Context, client *http.Client, path string) (int64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return 0, err
+	}
+
+	mediaType, err := detectMediaType(file, path)
+	if err != nil {
+		return 0, err
+	}
+
+	category, err := mediaCategory(mediaType)
+	if err != nil {
+		return 0, err
+	}
+
+	var res mediaUploadResponse
+
+	err = postMediaForm(ctx, client, url.Values{
+		"command":        {"INIT"},
+		"total_bytes":    {strconv.FormatInt(info.Size(), 10)},
+		"media_type":     {mediaType},
+		"media_category": {category},
+	}, &res)
+	if err != nil {
+		return 0, fmt.Errorf("INIT failed: %w", err)
+	}
+
+	mediaID := res.MediaID
+	chunk := make([]byte, mediaChunkSize)
+
+	for segment := 0; ; segment++ {
+		n, err := io.ReadFull(file, chunk)
+		if err == io.EOF {
+			break
+		}
+		if err != nil && err != io.ErrUnexpectedEOF {
+			return 0, err
+		}
+
+		err = appendMediaChunk(ctx, client, mediaID, segment, chunk[:n])
+		if err != nil {
+			return 0, fmt.Errorf("APPEND of segment %d failed: %w", segment, err)
+		}
+	}
+
+	err = postMediaForm(ctx, client, url.Values{
+		"command":  {"FINALIZE"},
+		"media_id": {strconv.FormatInt(mediaID, 10)},
+	}, &res)
+	if err != nil {
+		return 0, fmt.Errorf("FINALIZE failed: %w", err)
+	}
+
+	for res.ProcessingInfo != nil {
+		switch res.ProcessingInfo.State {
+		case "succeeded":
+			return mediaID, nil
+		case "failed":
+			if res.ProcessingInfo.Error != nil {
+				return 0, fmt.Errorf("processing failed: %s", res.ProcessingInfo.Error.Message)
+			}
+			return 0, fmt.Errorf("processing failed")
+		}
+
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		case <-time.After(time.Duration(res.ProcessingInfo.CheckAfterSecs) * time.Second):
+		}
+
+		res = mediaUploadResponse{}
+
+		err = getMediaStatus(ctx, client, mediaID, &res)
+		if err != nil {
+			return 0, fmt.Errorf("STATUS failed: %w", err)
+		}
+	}
+
+	return mediaID, nil
+}
+
+type mediaMetadata struct {
+	MediaID string `json:"media_id"`
+	AltText struct {
+		Text string `json:"text"`
+	} `json:"alt_text"`
+}
+
+// createMediaMetadata sets the alt text of an uploaded media, it must be
+// called before the media is attached to a tweet.
+// https://developer.twitter.com/en/docs/twitter-api/v1/media/upload-media/api-reference/post-media-metadata-create
+func createMediaMetadata(ctx context.Context, client *http.Client, mediaID int64, altText string) error {
+	metadata := mediaMetadata{
+		MediaID: strconv.FormatInt(mediaID, 10),
+	}
+	metadata.AltText.Text = altText
+
+	body, err := json.Marshal(metadata)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, mediaMetadataURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	return doAPIRequest(client, req, nil)
+}
+
+func postMediaForm(ctx context.Context, client *http.Client, form url.Values, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, mediaUploadURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return doAPIRequest(client, req, v)
+}
+
+func appendMediaChunk(ctx context.Context, client *http.Client, mediaID int64, segment int, data []byte) error {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	_ = writer.WriteField("command", "APPEND")
+	_ = writer.WriteField("media_id", strconv.FormatInt(mediaID, 10))
+	_ = writer.WriteField("segment_index", strconv.Itoa(segment))
+
+	part, err := writer.CreateFormFile("media", "blob")
+	if err != nil {
+		return err
+	}
+	if _, err := part.Write(data); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, mediaUploadURL, &body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	return doAPIRequest(client, req, nil)
+}
+
+func getMediaStatus(ctx context.Context, client *http.Client, mediaID int64, v interface{}) error {
+	query := url.Values{
+		"command":  {"STATUS"},
+		"media_id": {strconv.FormatInt(mediaID, 10)},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, mediaUploadURL+"?"+query.Encode(), nil)
+	if err != nil {
+		return err
+	}
+
+	return doAPIRequest(client, req, v)
+}
+
+// detectMediaType sniffs the content type of the file, falling back to its
+// extension when the content is not recognized.
+func detectMediaType(file *os.File, path string) (string, error) {
+	header := make([]byte, 512)
+
+	n, err := file.Read(header)
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	mediaType := http.DetectContentType(header[:n])
+
+	if mediaType == "application/octet-stream" {
+		if byExtension := mime.TypeByExtension(filepath.Ext(path)); byExtension != "" {
+			mediaType = byExtension
+		}
+	}
+
+	mediaType, _, err = mime.ParseMediaType(mediaType)
+
+	return mediaType, err
+}
+
+func mediaCategory(mediaType string) (string, error) {
+	switch mediaType {
+	case "image/jpeg", "image/png", "image/webp", "image/bmp":
+		return "tweet_image", nil
+	case "image/gif":
+		return "tweet_gif", nil
+	case "video/mp4", "video/quicktime":
+		return "tweet_video", nil
+	}
+
+	return "", fmt.Errorf("unsupported media type %s, only images, GIFs and MP4 videos can be attached to a tweet", mediaType)
+}
+
+// fileMediaCategory returns the media category of the file at path.
+func fileMediaCategory(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	mediaType, err := detectMediaType(file, path)
+	if err != nil {
+		return "", err
+	}
+
+	return mediaCategory(mediaType)
+}
+
+// fileHash returns the hex encoded SHA-256 checksum of the file at path.
+func fileHash(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
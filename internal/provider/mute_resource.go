@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var _ tfsdk.ResourceType = muteResourceType{}
+
+// https://developer.twitter.com/en/docs/twitter-api/v1/accounts-and-users/mute-block-report-users/api-reference/post-mutes-users-create
+var muteRelationship = userRelationship{
+	Name:        "mute",
+	Connection:  "muting",
+	CreatePath:  "mutes/users/create.json",
+	DestroyPath: "mutes/users/destroy.json",
+}
+
+type muteResourceType struct{}
+
+func (t muteResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return userRelationshipSchema("Mutes a user for the authenticating user. Muted users are not notified."), nil
+}
+
+func (t muteResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return userRelationshipResource{
+		provider:     provider,
+		relationship: muteRelationship,
+	}, diags
+}
@@ -0,0 +1,402 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sebastianmarines/terraform-provider-twitter/internal/utils"
+	"github.com/sebastianmarines/terraform-provider-twitter/internal/validators"
+)
+
+var _ tfsdk.ResourceType = pinnedListsResourceType{}
+var _ tfsdk.Resource = pinnedListsResource{}
+var _ tfsdk.ResourceWithImportState = pinnedListsResource{}
+
+type pinnedListsResourceType struct{}
+
+func (t pinnedListsResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Manages the Lists pinned by the authenticating user through the v2 API. Lists that are pinned but not declared in this resource are unpinned, so only one instance of this resource should be declared.",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "The ID of the authenticated user.",
+				Type:                types.Int64Type,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"list_ids": {
+				MarkdownDescription: "The IDs of the Lists to pin. Up to 5 Lists can be pinned.",
+				Type: types.SetType{
+					ElemType: types.Int64Type,
+				},
+				Required: true,
+				Validators: []tfsdk.AttributeValidator{
+					validators.SetSize(0, 5),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t pinnedListsResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return pinnedListsResource{
+		provider: provider,
+	}, diags
+}
+
+type pinnedListsResourceData struct {
+	ID      types.Int64 `tfsdk:"id"`
+	ListIDs types.Set   `tfsdk:"list_ids"`
+}
+
+func newPinnedListsResourceData(userID int64, listIDs []int64) *pinnedListsResourceData {
+	data := &pinnedListsResourceData{
+		ID: types.Int64{Value: userID},
+		ListIDs: types.Set{
+			ElemType: types.Int64Type,
+			Elems:    []attr.Value{},
+		},
+	}
+
+	for _, id := range listIDs {
+		data.ListIDs.Elems = append(data.ListIDs.Elems, types.Int64{Value: id})
+	}
+
+	return data
+}
+
+type pinnedListRequest struct {
+	ListID string `json:"list_id"`
+}
+
+type pinnedListsResponse struct {
+	Data []struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"data"`
+}
+
+type pinnedListsResource struct {
+	provider provider
+}
+
+func (t pinnedListsResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, t.provider.configured)
+	if err != nil {
+		return
+	}
+
+	userID, err := t.provider.authenticatedUserID(&resp.Diagnostics)
+	if err != nil {
+		return
+	}
+
+	var data pinnedListsResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listIDs := t.sync(ctx, userID, &data, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, newPinnedListsResourceData(userID, listIDs))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r pinnedListsResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, r.provider.configured)
+	if err != nil {
+		return
+	}
+
+	userID, err := r.provider.authenticatedUserID(&resp.Diagnostics)
+	if err != nil {
+		return
+	}
+
+	var data pinnedListsResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listIDs, err := r.getPinnedListIDs(ctx, userID)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read pinned lists",
+			fmt.Sprintf("Unable to read the pinned lists, got error: %s", err),
+		)
+		return
+	}
+
+	// Lists pinned or unpinned outside of Terraform show up as a change of
+	// list_ids.
+	diags = resp.State.Set(ctx, newPinnedListsResourceData(userID, listIDs))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r pinnedListsResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, r.provider.configured)
+	if err != nil {
+		return
+	}
+
+	userID, err := r.provider.authenticatedUserID(&resp.Diagnostics)
+	if err != nil {
+		return
+	}
+
+	var data pinnedListsResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listIDs := r.sync(ctx, userID, &data, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, newPinnedListsResourceData(userID, listIDs))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r pinnedListsResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, r.provider.configured)
+	if err != nil {
+		return
+	}
+
+	userID, err := r.provider.authenticatedUserID(&resp.Diagnostics)
+	if err != nil {
+		return
+	}
+
+	var data pinnedListsResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var listIDs []int64
+
+	diags = data.ListIDs.ElementsAs(ctx, &listIDs, false)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, listID := range listIDs {
+		err = r.unpinList(ctx, userID, listID)
+
+		if err != nil && !isNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Could not unpin list",
+				fmt.Sprintf("Unable to unpin list %d, got error: %s", listID, err),
+			)
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r pinnedListsResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, r.provider.configured)
+	if err != nil {
+		return
+	}
+
+	userID, err := r.provider.authenticatedUserID(&resp.Diagnostics)
+	if err != nil {
+		return
+	}
+
+	if req.ID != "me" {
+		userId, err := strconv.ParseInt(req.ID, 10, 64)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Could not import pinned lists",
+				fmt.Sprintf("Import ID must be \"me\" or the numeric ID of the authenticated user, got: %q", req.ID),
+			)
+			return
+		}
+
+		if userId != userID {
+			resp.Diagnostics.AddError(
+				"Could not import pinned lists",
+				fmt.Sprintf("User ID %d does not belong to the authenticated user (ID %d)", userId, userID),
+			)
+			return
+		}
+	}
+
+	listIDs, err := r.getPinnedListIDs(ctx, userID)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not import pinned lists",
+			fmt.Sprintf("Unable to read the pinned lists, got error: %s", err),
+		)
+		return
+	}
+
+	diags := resp.State.Set(ctx, newPinnedListsResourceData(userID, listIDs))
+	resp.Diagnostics.Append(diags...)
+}
+
+// sync unpins the Lists that are no longer planned before pinning the new
+// ones, so that the limit of pinned Lists is not exceeded in between. It
+// returns the IDs of the pinned Lists.
+func (r pinnedListsResource) sync(ctx context.Context, userID int64, data *pinnedListsResourceData, diags *diag.Diagnostics) []int64 {
+	var listIDs []int64
+
+	diags.Append(data.ListIDs.ElementsAs(ctx, &listIDs, false)...)
+
+	if diags.HasError() {
+		return nil
+	}
+
+	current, err := r.getPinnedListIDs(ctx, userID)
+
+	if err != nil {
+		diags.AddError(
+			"Could not read pinned lists",
+			fmt.Sprintf("Unable to read the pinned lists, got error: %s", err),
+		)
+		return nil
+	}
+
+	desired := make(map[int64]bool, len(listIDs))
+	for _, listID := range listIDs {
+		desired[listID] = true
+	}
+
+	pinned := make(map[int64]bool, len(current))
+	for _, listID := range current {
+		pinned[listID] = true
+
+		if desired[listID] {
+			continue
+		}
+
+		err = r.unpinList(ctx, userID, listID)
+
+		if err != nil && !isNotFound(err) {
+			diags.AddError(
+				"Could not unpin list",
+				fmt.Sprintf("Unable to unpin list %d, got error: %s", listID, err),
+			)
+			return nil
+		}
+	}
+
+	for _, listID := range listIDs {
+		if pinned[listID] {
+			continue
+		}
+
+		err = r.pinList(ctx, userID, listID)
+
+		if err != nil {
+			diags.AddError(
+				"Could not pin list",
+				fmt.Sprintf("Unable to pin list %d, got error %s", listID, err),
+			)
+			return nil
+		}
+	}
+
+	return listIDs
+}
+
+func (r pinnedListsResource) pinnedListsURL(userID int64) string {
+	return fmt.Sprintf("%susers/%d/pinned_lists", twitterAPIv2, userID)
+}
+
+func (r pinnedListsResource) pinList(ctx context.Context, userID int64, listID int64) error {
+	body, err := json.Marshal(pinnedListRequest{
+		ListID: strconv.FormatInt(listID, 10),
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.pinnedListsURL(userID), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	return doAPIRequest(&r.provider.httpClient, req, nil)
+}
+
+func (r pinnedListsResource) unpinList(ctx context.Context, userID int64, listID int64) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, r.pinnedListsURL(userID)+"/"+strconv.FormatInt(listID, 10), nil)
+	if err != nil {
+		return err
+	}
+
+	return doAPIRequest(&r.provider.httpClient, req, nil)
+}
+
+// getPinnedListIDs returns the IDs of the Lists pinned by the authenticated
+// user.
+func (r pinnedListsResource) getPinnedListIDs(ctx context.Context, userID int64) ([]int64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.pinnedListsURL(userID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res pinnedListsResponse
+
+	err = doAPIRequest(&r.provider.httpClient, req, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	listIDs := make([]int64, 0, len(res.Data))
+
+	for _, list := range res.Data {
+		listID, err := strconv.ParseInt(list.ID, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		listIDs = append(listIDs, listID)
+	}
+
+	return listIDs, nil
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"k8s.io/apimachinery/pkg/util/rand"
+)
+
+func TestAccPinnedListsResource(t *testing.T) {
+	firstName := "acc-" + rand.String(5)
+	secondName := "acc-" + rand.String(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPinnedListsResourceConfig(firstName, secondName, "twitter_list.first.id, twitter_list.second.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("twitter_pinned_lists.acc", "list_ids.#", "2"),
+					resource.TestCheckResourceAttrSet("twitter_pinned_lists.acc", "id"),
+				),
+			},
+			// Import the pinned lists of the authenticated user
+			{
+				ResourceName:      "twitter_pinned_lists.acc",
+				ImportState:       true,
+				ImportStateId:     "me",
+				ImportStateVerify: true,
+			},
+			// Lists removed from the set are unpinned
+			{
+				Config: testAccPinnedListsResourceConfig(firstName, secondName, "twitter_list.second.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("twitter_pinned_lists.acc", "list_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("twitter_pinned_lists.acc", "list_ids.*", "twitter_list.second", "id"),
+				),
+			},
+			// Up to 5 Lists can be pinned
+			{
+				Config: `
+resource "twitter_pinned_lists" "acc" {
+  list_ids = [1, 2, 3, 4, 5, 6]
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Must contain between 0 and 5 elements, got: 6"),
+			},
+		},
+	})
+}
+
+func testAccPinnedListsResourceConfig(firstName string, secondName string, listIDs string) string {
+	return fmt.Sprintf(`
+resource "twitter_list" "first" {
+  name = %[1]q
+}
+
+resource "twitter_list" "second" {
+  name = %[2]q
+}
+
+resource "twitter_pinned_lists" "acc" {
+  list_ids = [%[3]s]
+}`, firstName, secondName, listIDs)
+
+}
@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/dghubble/go-twitter/twitter"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sebastianmarines/terraform-provider-twitter/internal/utils"
+	"github.com/sebastianmarines/terraform-provider-twitter/internal/validators"
+)
+
+var _ tfsdk.DataSourceType = placesDataSourceType{}
+var _ tfsdk.DataSource = placesDataSource{}
+
+type placesDataSourceType struct{}
+
+func (t placesDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Searches for places that can be attached to a Tweet.",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "Identifier of the search.",
+				Type:                types.StringType,
+				Computed:            true,
+			},
+			"query": {
+				MarkdownDescription: "Free-form text to match against while executing a geo-based query, best suited for finding nearby locations by name.",
+				Type:                types.StringType,
+				Optional:            true,
+			},
+			"latitude": {
+				MarkdownDescription: "The latitude to search around.",
+				Type:                types.Float64Type,
+				Optional:            true,
+				Validators: []tfsdk.AttributeValidator{
+					validators.Latitude(),
+				},
+			},
+			"longitude": {
+				MarkdownDescription: "The longitude to search around.",
+				Type:                types.Float64Type,
+				Optional:            true,
+				Validators: []tfsdk.AttributeValidator{
+					validators.Longitude(),
+				},
+			},
+			"granularity": {
+				MarkdownDescription: "The minimal granularity of place types to return, one of `poi`, `neighborhood`, `city`, `admin` or `country`.",
+				Type:                types.StringType,
+				Optional:            true,
+				Validators: []tfsdk.AttributeValidator{
+					validators.OneOf("poi", "neighborhood", "city", "admin", "country"),
+				},
+			},
+			"max_results": {
+				MarkdownDescription: "A hint as to the number of results to return.",
+				Type:                types.Int64Type,
+				Optional:            true,
+			},
+			"places": {
+				MarkdownDescription: "The places matching the search.",
+				Computed:            true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"id": {
+						MarkdownDescription: "ID of the place, to be used as `place_id` when geotagging a Tweet.",
+						Type:                types.StringType,
+						Computed:            true,
+					},
+					"name": {
+						MarkdownDescription: "Short human-readable representation of the place’s name.",
+						Type:                types.StringType,
+						Computed:            true,
+					},
+					"full_name": {
+						MarkdownDescription: "Full human-readable representation of the place’s name.",
+						Type:                types.StringType,
+						Computed:            true,
+					},
+					"place_type": {
+						MarkdownDescription: "The type of location represented by this place.",
+						Type:                types.StringType,
+						Computed:            true,
+					},
+					"country": {
+						MarkdownDescription: "Name of the country containing this place.",
+						Type:                types.StringType,
+						Computed:            true,
+					},
+					"country_code": {
+						MarkdownDescription: "Shortened country code representing the country containing this place.",
+						Type:                types.StringType,
+						Computed:            true,
+					},
+				}),
+			},
+		},
+	}, nil
+}
+
+func (t placesDataSourceType) NewDataSource(ctx context.Context, in tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return placesDataSource{
+		provider: provider,
+	}, diags
+}
+
+type placesDataSourceData struct {
+	ID          types.String    `tfsdk:"id"`
+	Query       types.String    `tfsdk:"query"`
+	Latitude    types.Float64   `tfsdk:"latitude"`
+	Longitude   types.Float64   `tfsdk:"longitude"`
+	Granularity types.String    `tfsdk:"granularity"`
+	MaxResults  types.Int64     `tfsdk:"max_results"`
+	Places      []placeItemData `tfsdk:"places"`
+}
+
+type placeItemData struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	FullName    types.String `tfsdk:"full_name"`
+	PlaceType   types.String `tfsdk:"place_type"`
+	Country     types.String `tfsdk:"country"`
+	CountryCode types.String `tfsdk:"country_code"`
+}
+
+type geoSearchResponse struct {
+	Result struct {
+		Places []twitter.Place `json:"places"`
+	} `json:"result"`
+}
+
+type placesDataSource struct {
+	provider provider
+}
+
+func (d placesDataSource) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, d.provider.configured)
+	if err != nil {
+		return
+	}
+
+	var data placesDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Query.Null && (data.Latitude.Null || data.Longitude.Null) {
+		resp.Diagnostics.AddError(
+			"Missing required argument",
+			"Either a query or latitude and longitude are required, but no definition was found.",
+		)
+		return
+	}
+
+	query := url.Values{}
+
+	if !data.Query.Null {
+		query.Set("query", data.Query.Value)
+	}
+
+	if !data.Latitude.Null && !data.Longitude.Null {
+		query.Set("lat", strconv.FormatFloat(data.Latitude.Value, 'f', -1, 64))
+		query.Set("long", strconv.FormatFloat(data.Longitude.Value, 'f', -1, 64))
+	}
+
+	if !data.Granularity.Null {
+		query.Set("granularity", data.Granularity.Value)
+	}
+
+	if !data.MaxResults.Null {
+		query.Set("max_results", strconv.FormatInt(data.MaxResults.Value, 10))
+	}
+
+	_req, err := http.NewRequestWithContext(ctx, http.MethodGet, twitterAPIv1+"geo/search.json?"+query.Encode(), nil)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not search places",
+			fmt.Sprintf("Unable to search places, got error: %s", err),
+		)
+		return
+	}
+
+	var result geoSearchResponse
+
+	err = doAPIRequest(&d.provider.httpClient, _req, &result)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not search places",
+			fmt.Sprintf("Unable to search places, got error: %s", err),
+		)
+		return
+	}
+
+	data.ID = types.String{Value: query.Encode()}
+	data.Places = []placeItemData{}
+
+	for _, place := range result.Result.Places {
+		data.Places = append(data.Places, placeItemData{
+			ID:          types.String{Value: place.ID},
+			Name:        types.String{Value: place.Name},
+			FullName:    types.String{Value: strings.TrimSpace(place.FullName)},
+			PlaceType:   types.String{Value: place.PlaceType},
+			Country:     types.String{Value: place.Country},
+			CountryCode: types.String{Value: place.CountryCode},
+		})
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+}
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccPlacesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPlacesDataSourceConfig("query = \"Toronto\"\n  granularity = \"city\""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.twitter_places.acc", "places.0.id"),
+					resource.TestCheckResourceAttr("data.twitter_places.acc", "places.0.place_type", "city"),
+				),
+			},
+			{
+				Config:      testAccPlacesDataSourceConfig("granularity = \"city\""),
+				ExpectError: regexp.MustCompile("Either a query or latitude and longitude are required"),
+			},
+			{
+				Config:      testAccPlacesDataSourceConfig("query = \"Toronto\"\n  granularity = \"street\""),
+				ExpectError: regexp.MustCompile("Value must be one of: poi, neighborhood, city, admin, country"),
+			},
+		},
+	})
+}
+
+func testAccPlacesDataSourceConfig(arguments string) string {
+	return fmt.Sprintf(`
+data "twitter_places" "acc" {
+  %[1]s
+}`, arguments)
+
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 
 	"github.com/dghubble/go-twitter/twitter"
@@ -17,6 +18,7 @@ import (
 
 var _ tfsdk.ResourceType = profileResourceType{}
 var _ tfsdk.Resource = profileResource{}
+var _ tfsdk.ResourceWithImportState = profileResource{}
 
 type profileResourceType struct{}
 
@@ -272,6 +274,59 @@ func (r profileResource) Delete(ctx context.Context, req tfsdk.DeleteResourceReq
 	resp.State.RemoveResource(ctx)
 }
 
+func (r profileResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, r.provider.configured)
+	if err != nil {
+		return
+	}
+
+	params := &twitter.AccountVerifyParams{
+		IncludeEntities: twitter.Bool(true),
+		SkipStatus:      twitter.Bool(true),
+	}
+
+	user, _, err := r.provider.client.Accounts.VerifyCredentials(params)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not import profile",
+			fmt.Sprintf("Unable to read the authenticated user, got error: %s", err),
+		)
+		return
+	}
+
+	if req.ID != "me" {
+		userId, err := strconv.ParseInt(req.ID, 10, 64)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Could not import profile",
+				fmt.Sprintf("Import ID must be \"me\" or the numeric ID of the authenticated user, got: %q", req.ID),
+			)
+			return
+		}
+
+		if userId != user.ID {
+			resp.Diagnostics.AddError(
+				"Could not import profile",
+				fmt.Sprintf("User ID %d does not belong to the authenticated user (@%s, ID %d)", userId, user.ScreenName, user.ID),
+			)
+			return
+		}
+	}
+
+	profile := &profileResourceData{
+		ID:          types.Int64{Value: user.ID},
+		Name:        types.String{Value: user.Name},
+		URL:         types.String{Value: getExpandedProfileUrl(user)},
+		Location:    types.String{Value: user.Location},
+		Description: types.String{Value: user.Description},
+	}
+
+	diags := resp.State.Set(ctx, &profile)
+	resp.Diagnostics.Append(diags...)
+}
+
 type profileHTTPResponse struct {
 	ID int64 `json:"id"`
 }
@@ -301,3 +356,13 @@ func getProfileUrl(url string, originalUrl string) string {
 		return redirectUrl
 	}
 }
+
+// getExpandedProfileUrl returns the URL of the profile as the user entered it,
+// using the URL entities to expand the t.co link returned by the API.
+func getExpandedProfileUrl(user *twitter.User) string {
+	if user.Entities != nil && len(user.Entities.URL.Urls) > 0 && user.Entities.URL.Urls[0].ExpandedURL != "" {
+		return user.Entities.URL.Urls[0].ExpandedURL
+	}
+
+	return user.URL
+}
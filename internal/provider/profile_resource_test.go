@@ -28,6 +28,13 @@ func TestAccProfileResource(t *testing.T) {
 					resource.TestCheckResourceAttr("twitter_profile.acc", "description", desc),
 				),
 			},
+			// Import the authenticated user's profile
+			{
+				ResourceName:      "twitter_profile.acc",
+				ImportState:       true,
+				ImportStateId:     "me",
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"strconv"
+	"strings"
 
 	"github.com/dghubble/go-twitter/twitter"
 	"github.com/dghubble/oauth1"
@@ -26,6 +28,20 @@ type provider struct {
 	client     twitter.Client
 	httpClient http.Client
 
+	// oauth2Client authenticates with the OAuth 2.0 user context token
+	// required by some v2 endpoints, such as bookmarks. It is nil when no
+	// token is configured.
+	oauth2Client *http.Client
+
+	// userID is the ID of the authenticated user parsed from the access
+	// token, or 0 when it could not be parsed. Use authenticatedUserID to
+	// read it.
+	userID int64
+
+	// refreshMetrics is false when the engagement counters of the tweets
+	// must not be refreshed, so they are kept as they were in the state.
+	refreshMetrics bool
+
 	// configured is set to true at the end of the Configure method.
 	// This can be used in Resource and DataSource implementations to verify
 	// that the provider was previously configured.
@@ -39,10 +55,12 @@ type provider struct {
 
 // providerData can be used to store data from the Terraform configuration.
 type providerData struct {
-	ApiKey       types.String `tfsdk:"api_key"`
-	ApiSecretKey types.String `tfsdk:"api_secret_key"`
-	AccessToken  types.String `tfsdk:"access_token"`
-	AccessSecret types.String `tfsdk:"access_token_secret"`
+	ApiKey         types.String `tfsdk:"api_key"`
+	ApiSecretKey   types.String `tfsdk:"api_secret_key"`
+	AccessToken    types.String `tfsdk:"access_token"`
+	AccessSecret   types.String `tfsdk:"access_token_secret"`
+	OAuth2Token    types.String `tfsdk:"oauth2_access_token"`
+	RefreshMetrics types.Bool   `tfsdk:"refresh_metrics"`
 }
 
 func (p *provider) Configure(ctx context.Context, req tfsdk.ConfigureProviderRequest, resp *tfsdk.ConfigureProviderResponse) {
@@ -54,6 +72,8 @@ func (p *provider) Configure(ctx context.Context, req tfsdk.ConfigureProviderReq
 		return
 	}
 
+	p.refreshMetrics = data.RefreshMetrics.Null || data.RefreshMetrics.Unknown || data.RefreshMetrics.Value
+
 	var apiKey string
 	var apiSecretKey string
 	var accessToken string
@@ -153,24 +173,82 @@ func (p *provider) Configure(ctx context.Context, req tfsdk.ConfigureProviderReq
 
 	client := twitter.NewClient(httpClient)
 
+	// Access tokens are prefixed with the ID of the user they belong to. When
+	// the prefix is missing, the user is looked up by the resources that need
+	// it, so that configuring the provider does not require a request.
+	userID, _ := strconv.ParseInt(strings.SplitN(accessToken, "-", 2)[0], 10, 64)
+
+	var oauth2Token string
+
+	if data.OAuth2Token.Null {
+		oauth2Token = os.Getenv("TWITTER_OAUTH2_ACCESS_TOKEN")
+	} else {
+		oauth2Token = data.OAuth2Token.Value
+	}
+
+	if oauth2Token != "" {
+		p.oauth2Client = &http.Client{
+			Transport: &bearerTransport{
+				token: oauth2Token,
+				base:  http.DefaultTransport,
+			},
+		}
+	}
+
 	p.client = *client
 	p.httpClient = *httpClient
+	p.userID = userID
 
 	p.configured = true
 }
 
+// authenticatedUserID returns the ID of the authenticated user, which the
+// endpoints scoped to a user take in their path. The credentials are only
+// verified when the ID could not be parsed from the access token.
+func (p provider) authenticatedUserID(d *diag.Diagnostics) (int64, error) {
+	if p.userID != 0 {
+		return p.userID, nil
+	}
+
+	user, _, err := p.client.Accounts.VerifyCredentials(&twitter.AccountVerifyParams{
+		SkipStatus: twitter.Bool(true),
+	})
+
+	if err != nil {
+		d.AddError(
+			"Unable to verify Twitter credentials",
+			fmt.Sprintf("Unable to read the authenticated user, got error %s", err),
+		)
+		return 0, err
+	}
+
+	return user.ID, nil
+}
+
 func (p *provider) GetResources(ctx context.Context) (map[string]tfsdk.ResourceType, diag.Diagnostics) {
 	return map[string]tfsdk.ResourceType{
-		"twitter_tweet":   tweetResourceType{},
-		"twitter_profile": profileResourceType{},
-		"twitter_follow":  followResourceType{},
+		"twitter_tweet":             tweetResourceType{},
+		"twitter_profile":           profileResourceType{},
+		"twitter_follow":            followResourceType{},
+		"twitter_follows":           followsResourceType{},
+		"twitter_thread":            threadResourceType{},
+		"twitter_retweet":           retweetResourceType{},
+		"twitter_like":              likeResourceType{},
+		"twitter_bookmark":          bookmarkResourceType{},
+		"twitter_mute":              muteResourceType{},
+		"twitter_block":             blockResourceType{},
+		"twitter_list":              listResourceType{},
+		"twitter_list_members":      listMembersResourceType{},
+		"twitter_list_subscription": listSubscriptionResourceType{},
+		"twitter_pinned_lists":      pinnedListsResourceType{},
 	}, nil
 }
 
 func (p *provider) GetDataSources(ctx context.Context) (map[string]tfsdk.DataSourceType, diag.Diagnostics) {
 	return map[string]tfsdk.DataSourceType{
-		"twitter_tweet": tweetDataSourceType{},
-		"twitter_user":  userDataSourceType{},
+		"twitter_tweet":  tweetDataSourceType{},
+		"twitter_user":   userDataSourceType{},
+		"twitter_places": placesDataSourceType{},
 	}, nil
 }
 
@@ -186,6 +264,8 @@ To configure the provider, you must set the required variables in the provider c
 - TWITTER_ACCESS_TOKEN
 - TWITTER_ACCESS_TOKEN_SECRET
 
+The bookmarks endpoints only accept an OAuth 2.0 user context token, which can be set with the TWITTER_OAUTH2_ACCESS_TOKEN environment variable.
+
 > In order to get the required keys go to https://developer.twitter.com/ and apply for a developer account
 		`,
 		Attributes: map[string]tfsdk.Attribute{
@@ -213,6 +293,17 @@ To configure the provider, you must set the required variables in the provider c
 				Type:                types.StringType,
 				Sensitive:           true,
 			},
+			"oauth2_access_token": {
+				MarkdownDescription: "Twitter OAuth 2.0 user context access token, obtained with the authorization code flow with PKCE. Only required by `twitter_bookmark`, with the `bookmark.read`, `bookmark.write`, `tweet.read` and `users.read` scopes.",
+				Optional:            true,
+				Type:                types.StringType,
+				Sensitive:           true,
+			},
+			"refresh_metrics": {
+				MarkdownDescription: "Whether to refresh the engagement counters of Tweets, such as `favorite_count`, when reading them. When set to `false`, the counters keep the values read when the Tweet was created or imported. Defaults to `true`.",
+				Optional:            true,
+				Type:                types.BoolType,
+			},
 		},
 	}, nil
 }
@@ -1,11 +1,18 @@
 package provider
 
 import (
+	"context"
+	"io"
+	"net/http"
 	"os"
+	"strings"
 	"testing"
 
+	"github.com/dghubble/go-twitter/twitter"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -30,3 +37,77 @@ func testAccPreCheck(t *testing.T) {
 		t.Error("Missing Twitter access secret")
 	}
 }
+
+// testAccPreCheckOAuth2 also requires the OAuth 2.0 user context token used by
+// the bookmarks endpoints.
+func testAccPreCheckOAuth2(t *testing.T) {
+	testAccPreCheck(t)
+
+	if v := os.Getenv("TWITTER_OAUTH2_ACCESS_TOKEN"); v == "" {
+		t.Error("Missing Twitter OAuth 2.0 access token")
+	}
+}
+
+// testAccPreCheckTestAccount also requires the screen name of an account
+// controlled by the test runner, for tests that act on another account.
+func testAccPreCheckTestAccount(t *testing.T) {
+	testAccPreCheck(t)
+
+	if v := os.Getenv("TWITTER_ACC_TEST_SCREEN_NAME"); v == "" {
+		t.Error("Missing screen name of the acceptance test account")
+	}
+}
+
+// roundTripFunc stubs the Twitter API in unit tests.
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// testProvider returns a configured provider whose requests are answered by
+// the given function instead of the Twitter API.
+func testProvider(roundTrip roundTripFunc) provider {
+	httpClient := &http.Client{Transport: roundTrip}
+
+	return provider{
+		client:         *twitter.NewClient(httpClient),
+		httpClient:     *httpClient,
+		refreshMetrics: true,
+		configured:     true,
+	}
+}
+
+// testJSONResponse returns a response of the Twitter API with the given
+// status code and JSON body.
+func testJSONResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode:    statusCode,
+		Status:        http.StatusText(statusCode),
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+	}
+}
+
+// testState returns a state of the given resource type holding data.
+func testState(t *testing.T, resourceType tfsdk.ResourceType, data interface{}) tfsdk.State {
+	ctx := context.Background()
+
+	schema, diags := resourceType.GetSchema(ctx)
+	if diags.HasError() {
+		t.Fatalf("unexpected schema diagnostics %v", diags)
+	}
+
+	state := tfsdk.State{
+		Schema: schema,
+		Raw:    tftypes.NewValue(schema.TerraformType(ctx), nil),
+	}
+
+	diags = state.Set(ctx, data)
+	if diags.HasError() {
+		t.Fatalf("unexpected state diagnostics %v", diags)
+	}
+
+	return state
+}
@@ -0,0 +1,235 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/dghubble/go-twitter/twitter"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sebastianmarines/terraform-provider-twitter/internal/utils"
+)
+
+var _ tfsdk.ResourceType = retweetResourceType{}
+var _ tfsdk.Resource = retweetResource{}
+var _ tfsdk.ResourceWithImportState = retweetResource{}
+
+type retweetResourceType struct{}
+
+func (t retweetResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Retweets a Tweet as the authenticating user.",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "The integer representation of the unique identifier for the retweet.",
+				Type:                types.Int64Type,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"tweet_id": {
+				MarkdownDescription: "The ID of the Tweet to retweet.",
+				Type:                types.Int64Type,
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t retweetResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return retweetResource{
+		provider: provider,
+	}, diags
+}
+
+type retweetResourceData struct {
+	ID      types.Int64 `tfsdk:"id"`
+	TweetID types.Int64 `tfsdk:"tweet_id"`
+}
+
+type retweetResource struct {
+	provider provider
+}
+
+func (t retweetResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, t.provider.configured)
+	if err != nil {
+		return
+	}
+
+	var data retweetResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &twitter.StatusRetweetParams{
+		TrimUser: twitter.Bool(true),
+	}
+
+	retweet, _, err := t.provider.client.Statuses.Retweet(data.TweetID.Value, params)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not retweet",
+			fmt.Sprintf("Unable to retweet tweet %d, got error %s", data.TweetID.Value, err),
+		)
+		return
+	}
+
+	data.ID = types.Int64{Value: retweet.ID}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r retweetResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, r.provider.configured)
+	if err != nil {
+		return
+	}
+
+	var data retweetResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tweet, response, err := r.showRetweetedTweet(data.TweetID.Value)
+
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Could not read retweet",
+			fmt.Sprintf("Unable to read tweet %d, got error: %s", data.TweetID.Value, err),
+		)
+		return
+	}
+
+	// The retweet has been undone outside of Terraform.
+	if tweet.CurrentUserRetweet == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.Int64{Value: tweet.CurrentUserRetweet.ID}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r retweetResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	resp.Diagnostics.AddError(
+		"Update not supported",
+		"Update is not supported for retweet resource",
+	)
+	return
+}
+
+func (r retweetResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, r.provider.configured)
+	if err != nil {
+		return
+	}
+
+	var data retweetResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &twitter.StatusUnretweetParams{
+		TrimUser: twitter.Bool(true),
+	}
+
+	_, response, err := r.provider.client.Statuses.Unretweet(data.TweetID.Value, params)
+
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		resp.Diagnostics.AddError(
+			"Could not undo retweet",
+			fmt.Sprintf("Unable to undo retweet of tweet %d, got error: %s", data.TweetID.Value, err),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r retweetResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, r.provider.configured)
+	if err != nil {
+		return
+	}
+
+	tweetID, err := strconv.ParseInt(req.ID, 10, 64)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not import retweet",
+			fmt.Sprintf("Tweet ID must be an integer, got: %q", req.ID),
+		)
+		return
+	}
+
+	tweet, _, err := r.showRetweetedTweet(tweetID)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not import retweet",
+			fmt.Sprintf("Unable to read tweet with ID %d, got error: %s", tweetID, err),
+		)
+		return
+	}
+
+	if tweet.CurrentUserRetweet == nil {
+		resp.Diagnostics.AddError(
+			"Could not import retweet",
+			fmt.Sprintf("The authenticated user has not retweeted tweet %d", tweetID),
+		)
+		return
+	}
+
+	data := &retweetResourceData{
+		ID:      types.Int64{Value: tweet.CurrentUserRetweet.ID},
+		TweetID: types.Int64{Value: tweetID},
+	}
+
+	diags := resp.State.Set(ctx, data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// showRetweetedTweet reads a tweet including the retweet of the
+// authenticating user, if any, in its current_user_retweet field.
+func (r retweetResource) showRetweetedTweet(tweetID int64) (*twitter.Tweet, *http.Response, error) {
+	params := &twitter.StatusShowParams{
+		ID:               tweetID,
+		TrimUser:         twitter.Bool(true),
+		IncludeMyRetweet: twitter.Bool(true),
+		IncludeEntities:  twitter.Bool(false),
+	}
+
+	return r.provider.client.Statuses.Show(tweetID, params)
+}
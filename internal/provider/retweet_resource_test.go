@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"k8s.io/apimachinery/pkg/util/rand"
+)
+
+func TestAccRetweetResource(t *testing.T) {
+	tweetText := rand.String(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRetweetResourceConfig(tweetText),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("twitter_retweet.acc", "tweet_id", "twitter_tweet.acc", "id"),
+					resource.TestCheckResourceAttrSet("twitter_retweet.acc", "id"),
+				),
+			},
+			// Import the retweet by the ID of the retweeted tweet
+			{
+				ResourceName:      "twitter_retweet.acc",
+				ImportState:       true,
+				ImportStateIdFunc: testAccRetweetResourceImportStateIdFunc,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccRetweetResourceImportStateIdFunc(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources["twitter_retweet.acc"]
+	if !ok {
+		return "", fmt.Errorf("resource not found: twitter_retweet.acc")
+	}
+
+	return rs.Primary.Attributes["tweet_id"], nil
+}
+
+func testAccRetweetResourceConfig(text string) string {
+	return fmt.Sprintf(`
+resource "twitter_tweet" "acc" {
+  text = %[1]q
+}
+
+resource "twitter_retweet" "acc" {
+  tweet_id = twitter_tweet.acc.id
+}`, text)
+
+}
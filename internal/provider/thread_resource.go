@@ -0,0 +1,326 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dghubble/go-twitter/twitter"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sebastianmarines/terraform-provider-twitter/internal/utils"
+	"github.com/sebastianmarines/terraform-provider-twitter/internal/validators"
+)
+
+// statusesLookupBatchSize is the maximum number of tweets that can be
+// requested at once from statuses/lookup.
+const statusesLookupBatchSize = 100
+
+var _ tfsdk.ResourceType = threadResourceType{}
+var _ tfsdk.Resource = threadResource{}
+
+type threadResourceType struct{}
+
+func (t threadResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Posts a thread of Tweets, where every Tweet is a reply to the previous one. If any Tweet fails to post, the Tweets already posted are deleted.",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "The ID of the first Tweet of the thread.",
+				Type:                types.Int64Type,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"tweets": {
+				MarkdownDescription: "The ordered texts of the Tweets in the thread. Each one should not exceed 280 characters, where URLs count as 23 characters and CJK characters and emoji count as 2.",
+				Type: types.ListType{
+					ElemType: types.StringType,
+				},
+				Required: true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					validators.TweetListLength(1),
+				},
+			},
+			"tweet_ids": {
+				MarkdownDescription: "The IDs of the Tweets in the thread, in the same order as `tweets`.",
+				Type: types.ListType{
+					ElemType: types.Int64Type,
+				},
+				Computed: true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"user_id": {
+				MarkdownDescription: "The integer representation of the unique identifier for the user who posted the thread.",
+				Type:                types.Int64Type,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t threadResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return threadResource{
+		provider: provider,
+	}, diags
+}
+
+type threadResourceData struct {
+	ID       types.Int64 `tfsdk:"id"`
+	Tweets   types.List  `tfsdk:"tweets"`
+	TweetIDs types.List  `tfsdk:"tweet_ids"`
+	UserID   types.Int64 `tfsdk:"user_id"`
+}
+
+type threadResource struct {
+	provider provider
+}
+
+func (t threadResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, t.provider.configured)
+	if err != nil {
+		return
+	}
+
+	var data threadResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var texts []string
+
+	diags = data.Tweets.ElementsAs(ctx, &texts, false)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tweetIDs []int64
+	var userID int64
+
+	for i, text := range texts {
+		params := &twitter.StatusUpdateParams{
+			Status:   text,
+			TrimUser: twitter.Bool(true),
+		}
+
+		if i > 0 {
+			params.InReplyToStatusID = tweetIDs[i-1]
+		}
+
+		tweet, _, err := t.provider.client.Statuses.Update(text, params)
+
+		if err != nil {
+			detail := fmt.Sprintf("Unable to post tweet %d of the thread, got error %s", i+1, err.Error())
+
+			if rollbackErrs := t.destroyTweets(tweetIDs); len(rollbackErrs) > 0 {
+				detail += fmt.Sprintf("\n\nThe following tweets could not be rolled back and must be deleted manually:\n%s", strings.Join(rollbackErrs, "\n"))
+			}
+
+			resp.Diagnostics.AddError(
+				"Could not create thread",
+				detail,
+			)
+			return
+		}
+
+		tweetIDs = append(tweetIDs, tweet.ID)
+		userID = tweet.User.ID
+	}
+
+	thread := newThreadResourceData(texts, tweetIDs)
+	thread.UserID.Value = userID
+
+	diags = resp.State.Set(ctx, &thread)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r threadResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, r.provider.configured)
+	if err != nil {
+		return
+	}
+
+	var data threadResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var texts []string
+	var tweetIDs []int64
+
+	resp.Diagnostics.Append(data.Tweets.ElementsAs(ctx, &texts, false)...)
+	resp.Diagnostics.Append(data.TweetIDs.ElementsAs(ctx, &tweetIDs, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &twitter.StatusLookupParams{
+		TrimUser:        twitter.Bool(true),
+		IncludeEntities: twitter.Bool(false),
+	}
+
+	found := make(map[int64]bool, len(tweetIDs))
+
+	for start := 0; start < len(tweetIDs); start += statusesLookupBatchSize {
+		end := start + statusesLookupBatchSize
+		if end > len(tweetIDs) {
+			end = len(tweetIDs)
+		}
+
+		tweets, _, err := r.provider.client.Statuses.Lookup(tweetIDs[start:end], params)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Could not read thread",
+				fmt.Sprintf("Unable to read thread, got error: %s", err),
+			)
+			return
+		}
+
+		for _, tweet := range tweets {
+			found[tweet.ID] = true
+		}
+	}
+
+	// Tweets deleted outside of Terraform are dropped from the state, so
+	// the thread is planned for replacement.
+	var existingTexts []string
+	var existingIDs []int64
+
+	for i, id := range tweetIDs {
+		if found[id] && i < len(texts) {
+			existingTexts = append(existingTexts, texts[i])
+			existingIDs = append(existingIDs, id)
+		}
+	}
+
+	if len(existingIDs) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	thread := newThreadResourceData(existingTexts, existingIDs)
+	thread.UserID = data.UserID
+
+	diags = resp.State.Set(ctx, &thread)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r threadResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	resp.Diagnostics.AddError(
+		"Update not supported",
+		"Update is not supported for thread resource",
+	)
+	return
+}
+
+func (r threadResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, r.provider.configured)
+	if err != nil {
+		return
+	}
+
+	var data threadResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tweetIDs []int64
+
+	diags = data.TweetIDs.ElementsAs(ctx, &tweetIDs, false)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if errs := r.destroyTweets(tweetIDs); len(errs) > 0 {
+		resp.Diagnostics.AddError(
+			"Could not delete thread",
+			fmt.Sprintf("Unable to delete the following tweets:\n%s", strings.Join(errs, "\n")),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// destroyTweets deletes the given tweets, starting from the last one, and
+// returns a description of every tweet that could not be deleted. Tweets that
+// no longer exist are ignored.
+func (r threadResource) destroyTweets(tweetIDs []int64) []string {
+	var errs []string
+
+	params := &twitter.StatusDestroyParams{
+		TrimUser: twitter.Bool(true),
+	}
+
+	for i := len(tweetIDs) - 1; i >= 0; i-- {
+		_, response, err := r.provider.client.Statuses.Destroy(tweetIDs[i], params)
+
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				continue
+			}
+			errs = append(errs, fmt.Sprintf("- %d: %s", tweetIDs[i], err))
+		}
+	}
+
+	return errs
+}
+
+func newThreadResourceData(texts []string, tweetIDs []int64) *threadResourceData {
+	thread := &threadResourceData{
+		Tweets: types.List{
+			ElemType: types.StringType,
+			Elems:    []attr.Value{},
+		},
+		TweetIDs: types.List{
+			ElemType: types.Int64Type,
+			Elems:    []attr.Value{},
+		},
+	}
+
+	for _, text := range texts {
+		thread.Tweets.Elems = append(thread.Tweets.Elems, types.String{Value: text})
+	}
+
+	for _, id := range tweetIDs {
+		thread.TweetIDs.Elems = append(thread.TweetIDs.Elems, types.Int64{Value: id})
+	}
+
+	if len(tweetIDs) > 0 {
+		thread.ID.Value = tweetIDs[0]
+	}
+
+	return thread
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"k8s.io/apimachinery/pkg/util/rand"
+)
+
+func TestAccThreadResource(t *testing.T) {
+	tweets := []string{rand.String(5), rand.String(5), rand.String(5)}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccThreadResourceConfig(tweets),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("twitter_thread.acc", "tweets.#", "3"),
+					resource.TestCheckResourceAttr("twitter_thread.acc", "tweets.0", tweets[0]),
+					resource.TestCheckResourceAttr("twitter_thread.acc", "tweet_ids.#", "3"),
+					resource.TestCheckResourceAttrPair("twitter_thread.acc", "id", "twitter_thread.acc", "tweet_ids.0"),
+					resource.TestCheckResourceAttrSet("twitter_thread.acc", "user_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccThreadResourceValidators(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Every tweet of the thread is validated
+			{
+				Config:      testAccThreadResourceConfig([]string{rand.String(5), strings.Repeat("a", 281)}),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("got: 281 weighted characters"),
+			},
+			// A thread needs at least one tweet
+			{
+				Config:      testAccThreadResourceConfig([]string{}),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Must contain at least 1 tweets"),
+			},
+		},
+	})
+}
+
+func testAccThreadResourceConfig(tweets []string) string {
+	quoted := make([]string, len(tweets))
+	for i, tweet := range tweets {
+		quoted[i] = fmt.Sprintf("%q", tweet)
+	}
+
+	return fmt.Sprintf(`
+resource "twitter_thread" "acc" {
+  tweets = [%[1]s]
+}`, strings.Join(quoted, ", "))
+
+}
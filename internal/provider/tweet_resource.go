@@ -3,17 +3,27 @@ package provider
 import (
 	"context"
 	"fmt"
+	"html"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/dghubble/go-twitter/twitter"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/sebastianmarines/terraform-provider-twitter/internal/utils"
 	"github.com/sebastianmarines/terraform-provider-twitter/internal/validators"
 )
 
 var _ tfsdk.ResourceType = tweetResourceType{}
 var _ tfsdk.Resource = tweetResource{}
+var _ tfsdk.ResourceWithImportState = tweetResource{}
+var _ tfsdk.ResourceWithModifyPlan = tweetResource{}
+var _ tfsdk.ResourceWithValidateConfig = tweetResource{}
 
 type tweetResourceType struct{}
 
@@ -23,21 +33,39 @@ func (t tweetResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Di
 
 		Attributes: map[string]tfsdk.Attribute{
 			"id": {
-				MarkdownDescription: "The integer representation of the unique identifier for this Tweet.",
+				MarkdownDescription: "The integer representation of the unique identifier for this Tweet. Editing a Tweet changes its ID.",
 				Type:                types.Int64Type,
 				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
 			},
 			"text": {
-				MarkdownDescription: "The actual UTF-8 text of the status update. Should not exceed 280 characters.",
+				MarkdownDescription: "The actual UTF-8 text of the status update. Should not exceed 280 characters, where URLs count as 23 characters and CJK characters and emoji count as 2. Changing the text replaces the Tweet, unless it is edited according to `edit_strategy`.",
 				Type:                types.StringType,
 				Required:            true,
-				PlanModifiers: tfsdk.AttributePlanModifiers{
-					tfsdk.RequiresReplace(),
-				},
 				Validators: []tfsdk.AttributeValidator{
 					validators.TweetLength(),
 				},
 			},
+			"edit_strategy": {
+				MarkdownDescription: "How changes to `text` are applied, either `replace` to delete the Tweet and post a new one, or `edit` to edit the Tweet through the v2 API. Editing requires an account that can edit Tweets, and once the edit window has expired the Tweet is replaced instead. Defaults to `replace`.",
+				Type:                types.StringType,
+				Optional:            true,
+				Validators: []tfsdk.AttributeValidator{
+					validators.OneOf(editStrategyReplace, editStrategyEdit),
+				},
+			},
+			"editable_until": {
+				MarkdownDescription: "The time until which the Tweet can be edited, in ISO 8601 format.",
+				Type:                types.StringType,
+				Computed:            true,
+			},
+			"edits_remaining": {
+				MarkdownDescription: "The number of times the Tweet can still be edited.",
+				Type:                types.Int64Type,
+				Computed:            true,
+			},
 			"user_id": {
 				MarkdownDescription: "The integer representation of the unique identifier for the user who posted this Tweet.",
 				Type:                types.Int64Type,
@@ -49,39 +77,84 @@ func (t tweetResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Di
 				Computed:            true,
 			},
 			"in_reply_to_status_id": {
-				MarkdownDescription: "If the represented Tweet is a reply, this field will contain the integer representation of the original Tweet’s ID.",
+				MarkdownDescription: "The ID of an existing Tweet that this Tweet is in reply to. Unless `auto_populate_reply_metadata` is set, the text must mention the author of the referenced Tweet, otherwise the reply is posted as a standalone Tweet.",
 				Type:                types.Int64Type,
+				Optional:            true,
 				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"auto_populate_reply_metadata": {
+				MarkdownDescription: "If set to `true` and used with `in_reply_to_status_id`, leading @mentions will be looked up from the original Tweet, and added to the new Tweet from there. Cannot be used with `poll` or `reply_settings`.",
+				Type:                types.BoolType,
+				Optional:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
 			},
 			"in_reply_to_user_id": {
 				MarkdownDescription: "If the represented Tweet is a reply, this field will contain the integer representation of the original Tweet’s author ID.",
 				Type:                types.Int64Type,
 				Computed:            true,
 			},
+			"quote_tweet_id": {
+				MarkdownDescription: "The ID of an existing Tweet to quote. The link to the quoted Tweet is sent as an attachment, so it does not count towards the length of `text`.",
+				Type:                types.Int64Type,
+				Optional:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
 			"quoted_status_id": {
 				MarkdownDescription: "This field only surfaces when the Tweet is a quote Tweet. This field contains the integer value Tweet ID of the quoted Tweet.",
 				Type:                types.Int64Type,
 				Computed:            true,
 			},
+			"reply_settings": {
+				MarkdownDescription: "Who can reply to the Tweet, one of `everyone`, `mentionedUsers` or `following`. When set, the Tweet is posted through the v2 API. Defaults to `everyone`.",
+				Type:                types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					validators.OneOf(replySettingsEveryone, "mentionedUsers", "following"),
+				},
+			},
 			"quote_count": {
 				MarkdownDescription: "Indicates approximately how many times this Tweet has been quoted by Twitter users.",
 				Type:                types.Int64Type,
 				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
 			},
 			"reply_count": {
 				MarkdownDescription: "Number of times this Tweet has been replied to.",
 				Type:                types.Int64Type,
 				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
 			},
 			"retweet_count": {
 				MarkdownDescription: "Number of times this Tweet has been retweeted.",
 				Type:                types.Int64Type,
 				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
 			},
 			"favorite_count": {
 				MarkdownDescription: "Indicates approximately how many times this Tweet has been liked by Twitter users.",
 				Type:                types.Int64Type,
 				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
 			},
 			"possibly_sensitive": {
 				MarkdownDescription: "An indicator that the URL contained in the Tweet may contain content or media identified as sensitive content.",
@@ -94,6 +167,143 @@ func (t tweetResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Di
 				Computed:            true,
 			},
 		},
+		Blocks: map[string]tfsdk.Block{
+			"location": {
+				MarkdownDescription: "Geotags the Tweet with exact coordinates or with a place. Use the `twitter_places` data source to look up place IDs.",
+				NestingMode:         tfsdk.BlockNestingModeList,
+				MaxItems:            1,
+				Attributes: map[string]tfsdk.Attribute{
+					"latitude": {
+						MarkdownDescription: "The latitude of the location this Tweet refers to. Must be between -90 and 90, and requires `longitude`.",
+						Type:                types.Float64Type,
+						Optional:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+						Validators: []tfsdk.AttributeValidator{
+							validators.Latitude(),
+						},
+					},
+					"longitude": {
+						MarkdownDescription: "The longitude of the location this Tweet refers to. Must be between -180 and 180, and requires `latitude`.",
+						Type:                types.Float64Type,
+						Optional:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+						Validators: []tfsdk.AttributeValidator{
+							validators.Longitude(),
+						},
+					},
+					"place_id": {
+						MarkdownDescription: "A place in the world, as returned by the `twitter_places` data source.",
+						Type:                types.StringType,
+						Optional:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+						Validators: []tfsdk.AttributeValidator{
+							validators.PlaceID(),
+						},
+					},
+					"display_coordinates": {
+						MarkdownDescription: "Whether or not to put a pin on the exact coordinates the Tweet has been sent from. Cannot be used with `poll` or `reply_settings`.",
+						Type:                types.BoolType,
+						Optional:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+					},
+				},
+			},
+			"media": {
+				MarkdownDescription: "Local files to upload and attach to the Tweet. Up to 4 images, or a single GIF or MP4 video, can be attached.",
+				NestingMode:         tfsdk.BlockNestingModeList,
+				MaxItems:            4,
+				Attributes: map[string]tfsdk.Attribute{
+					"file": {
+						MarkdownDescription: "Path to the image, GIF or MP4 video to upload.",
+						Type:                types.StringType,
+						Required:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+					},
+					"alt_text": {
+						MarkdownDescription: "Description of the media for visually impaired users. Must not exceed 1000 characters.",
+						Type:                types.StringType,
+						Optional:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+						Validators: []tfsdk.AttributeValidator{
+							validators.StringLength(0, 1000),
+						},
+					},
+					"file_hash": {
+						MarkdownDescription: "SHA-256 checksum of the uploaded file. Changing the contents of the file causes the Tweet to be replaced.",
+						Type:                types.StringType,
+						Computed:            true,
+					},
+					"media_id": {
+						MarkdownDescription: "The ID of the uploaded media.",
+						Type:                types.Int64Type,
+						Computed:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			"poll": {
+				MarkdownDescription: "Attaches a poll to the Tweet. A Tweet with a poll is posted through the v2 API and cannot have media, quote another Tweet or be geotagged with coordinates.",
+				NestingMode:         tfsdk.BlockNestingModeList,
+				MaxItems:            1,
+				Attributes: map[string]tfsdk.Attribute{
+					"options": {
+						MarkdownDescription: "The choices of the poll, between 2 and 4 options of up to 25 characters.",
+						Type: types.ListType{
+							ElemType: types.StringType,
+						},
+						Required: true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+						Validators: []tfsdk.AttributeValidator{
+							validators.PollOptions(),
+						},
+					},
+					"duration_minutes": {
+						MarkdownDescription: "How long the poll stays open, between 5 and 10080 minutes.",
+						Type:                types.Int64Type,
+						Required:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+						Validators: []tfsdk.AttributeValidator{
+							validators.PollDuration(),
+						},
+					},
+					"votes": {
+						MarkdownDescription: "The number of votes of every option, in the same order as `options`.",
+						Type: types.ListType{
+							ElemType: types.Int64Type,
+						},
+						Computed: true,
+					},
+					"end_datetime": {
+						MarkdownDescription: "The time at which the poll closes, in ISO 8601 format.",
+						Type:                types.StringType,
+						Computed:            true,
+					},
+					"voting_status": {
+						MarkdownDescription: "Whether the poll is `open` or `closed`.",
+						Type:                types.StringType,
+						Computed:            true,
+					},
+				},
+			},
+		},
 	}, nil
 }
 
@@ -105,20 +315,387 @@ func (t tweetResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (
 	}, diags
 }
 
+// Values of edit_strategy.
+const (
+	editStrategyReplace = "replace"
+	editStrategyEdit    = "edit"
+)
+
+// replySettingsEveryone is the default value of reply_settings, which the v2
+// API does not accept and omits from its responses.
+const replySettingsEveryone = "everyone"
+
 type tweetResourceData struct {
-	ID                types.Int64  `tfsdk:"id"`
-	Text              types.String `tfsdk:"text"`
-	UserID            types.Int64  `tfsdk:"user_id"`
-	Source            types.String `tfsdk:"source"`
-	InReplyToStatusID types.Int64  `tfsdk:"in_reply_to_status_id"`
-	InReplyToUserID   types.Int64  `tfsdk:"in_reply_to_user_id"`
-	QuotedStatusID    types.Int64  `tfsdk:"quoted_status_id"`
-	QuoteCount        types.Int64  `tfsdk:"quote_count"`
-	ReplyCount        types.Int64  `tfsdk:"reply_count"`
-	RetweetCount      types.Int64  `tfsdk:"retweet_count"`
-	FavoriteCount     types.Int64  `tfsdk:"favorite_count"`
-	PossiblySensitive types.Bool   `tfsdk:"possibly_sensitive"`
-	Lang              types.String `tfsdk:"lang"`
+	ID                types.Int64         `tfsdk:"id"`
+	Text              types.String        `tfsdk:"text"`
+	EditStrategy      types.String        `tfsdk:"edit_strategy"`
+	EditableUntil     types.String        `tfsdk:"editable_until"`
+	EditsRemaining    types.Int64         `tfsdk:"edits_remaining"`
+	UserID            types.Int64         `tfsdk:"user_id"`
+	Source            types.String        `tfsdk:"source"`
+	InReplyToStatusID types.Int64         `tfsdk:"in_reply_to_status_id"`
+	AutoPopulate      types.Bool          `tfsdk:"auto_populate_reply_metadata"`
+	InReplyToUserID   types.Int64         `tfsdk:"in_reply_to_user_id"`
+	QuoteTweetID      types.Int64         `tfsdk:"quote_tweet_id"`
+	QuotedStatusID    types.Int64         `tfsdk:"quoted_status_id"`
+	ReplySettings     types.String        `tfsdk:"reply_settings"`
+	QuoteCount        types.Int64         `tfsdk:"quote_count"`
+	ReplyCount        types.Int64         `tfsdk:"reply_count"`
+	RetweetCount      types.Int64         `tfsdk:"retweet_count"`
+	FavoriteCount     types.Int64         `tfsdk:"favorite_count"`
+	PossiblySensitive types.Bool          `tfsdk:"possibly_sensitive"`
+	Lang              types.String        `tfsdk:"lang"`
+	Media             []tweetMediaData    `tfsdk:"media"`
+	Location          []tweetLocationData `tfsdk:"location"`
+	Poll              []tweetPollData     `tfsdk:"poll"`
+}
+
+type tweetPollData struct {
+	Options         types.List   `tfsdk:"options"`
+	DurationMinutes types.Int64  `tfsdk:"duration_minutes"`
+	Votes           types.List   `tfsdk:"votes"`
+	EndDatetime     types.String `tfsdk:"end_datetime"`
+	VotingStatus    types.String `tfsdk:"voting_status"`
+}
+
+type tweetLocationData struct {
+	Latitude           types.Float64 `tfsdk:"latitude"`
+	Longitude          types.Float64 `tfsdk:"longitude"`
+	PlaceID            types.String  `tfsdk:"place_id"`
+	DisplayCoordinates types.Bool    `tfsdk:"display_coordinates"`
+}
+
+type tweetMediaData struct {
+	File     types.String `tfsdk:"file"`
+	AltText  types.String `tfsdk:"alt_text"`
+	FileHash types.String `tfsdk:"file_hash"`
+	MediaID  types.Int64  `tfsdk:"media_id"`
+}
+
+// newTweetResourceData maps a tweet returned by the API to the resource state.
+func newTweetResourceData(tweet *twitter.Tweet) *tweetResourceData {
+	newTweet := &tweetResourceData{}
+
+	newTweet.ID.Value = tweet.ID
+	newTweet.Text.Value = tweetText(tweet, false, "")
+	newTweet.EditStrategy.Null = true
+	newTweet.EditableUntil.Null = true
+	newTweet.EditsRemaining.Null = true
+	newTweet.UserID.Value = tweet.User.ID
+	newTweet.Source.Value = tweet.Source
+	newTweet.InReplyToStatusID.Value = tweet.InReplyToStatusID
+	newTweet.AutoPopulate.Null = true
+	newTweet.InReplyToUserID.Value = tweet.InReplyToUserID
+	newTweet.QuoteTweetID = types.Int64{Value: tweet.QuotedStatusID, Null: tweet.QuotedStatusID == 0}
+	newTweet.QuotedStatusID.Value = tweet.QuotedStatusID
+	newTweet.ReplySettings.Null = true
+	newTweet.QuoteCount.Value = int64(tweet.QuoteCount)
+	newTweet.ReplyCount.Value = int64(tweet.ReplyCount)
+	newTweet.RetweetCount.Value = int64(tweet.RetweetCount)
+	newTweet.FavoriteCount.Value = int64(tweet.FavoriteCount)
+	newTweet.PossiblySensitive.Value = tweet.PossiblySensitive
+	newTweet.Lang.Value = tweet.Lang
+	newTweet.Media = []tweetMediaData{}
+	newTweet.Location = []tweetLocationData{}
+	newTweet.Poll = []tweetPollData{}
+
+	if tweet.Coordinates != nil || tweet.Place != nil {
+		location := tweetLocationData{
+			Latitude:           types.Float64{Null: true},
+			Longitude:          types.Float64{Null: true},
+			PlaceID:            types.String{Null: true},
+			DisplayCoordinates: types.Bool{Null: true},
+		}
+
+		// Twitter attaches a place to a tweet geotagged with coordinates,
+		// so the place is only set when it was the location given, and
+		// display_coordinates is left null as it is not part of the tweet.
+		if tweet.Coordinates != nil {
+			// Coordinates are returned in [longitude, latitude] order.
+			location.Longitude = types.Float64{Value: tweet.Coordinates.Coordinates[0]}
+			location.Latitude = types.Float64{Value: tweet.Coordinates.Coordinates[1]}
+		} else {
+			location.PlaceID = types.String{Value: tweet.Place.ID}
+		}
+
+		newTweet.Location = append(newTweet.Location, location)
+	}
+
+	return newTweet
+}
+
+// newPostedTweetResourceData maps a tweet that was posted but could not be read
+// back to the resource state, from its ID and the plan. The attributes that
+// are only known to the API are null until the next refresh.
+func newPostedTweetResourceData(id int64, plan *tweetResourceData) *tweetResourceData {
+	newTweet := &tweetResourceData{
+		ID:                types.Int64{Value: id},
+		Text:              plan.Text,
+		EditStrategy:      types.String{Null: true},
+		EditableUntil:     types.String{Null: true},
+		EditsRemaining:    types.Int64{Null: true},
+		UserID:            types.Int64{Null: true},
+		Source:            types.String{Null: true},
+		InReplyToStatusID: plan.InReplyToStatusID,
+		AutoPopulate:      types.Bool{Null: true},
+		InReplyToUserID:   types.Int64{Null: true},
+		QuoteTweetID:      plan.QuoteTweetID,
+		QuotedStatusID:    types.Int64{Null: true},
+		ReplySettings:     types.String{Null: true},
+		PossiblySensitive: types.Bool{Null: true},
+		Lang:              types.String{Null: true},
+		Media:             []tweetMediaData{},
+		Location:          []tweetLocationData{},
+		Poll:              []tweetPollData{},
+	}
+
+	if newTweet.InReplyToStatusID.Unknown {
+		newTweet.InReplyToStatusID = types.Int64{Null: true}
+	}
+
+	if newTweet.QuoteTweetID.Unknown {
+		newTweet.QuoteTweetID = types.Int64{Null: true}
+	}
+
+	return newTweet
+}
+
+// newTweetPollData maps a poll returned by the v2 API to the resource state.
+// A nil poll leaves the computed attributes null.
+func newTweetPollData(poll *tweetV2Poll) tweetPollData {
+	data := tweetPollData{
+		Options: types.List{
+			ElemType: types.StringType,
+			Elems:    []attr.Value{},
+		},
+		DurationMinutes: types.Int64{Null: true},
+		Votes:           types.List{ElemType: types.Int64Type, Null: true},
+		EndDatetime:     types.String{Null: true},
+		VotingStatus:    types.String{Null: true},
+	}
+
+	if poll == nil {
+		return data
+	}
+
+	data.DurationMinutes = types.Int64{Value: poll.DurationMinutes}
+	data.Votes = types.List{
+		ElemType: types.Int64Type,
+		Elems:    []attr.Value{},
+	}
+	data.EndDatetime = types.String{Value: poll.EndDatetime}
+	data.VotingStatus = types.String{Value: poll.VotingStatus}
+
+	for _, option := range poll.Options {
+		data.Options.Elems = append(data.Options.Elems, types.String{Value: option.Label})
+		data.Votes.Elems = append(data.Votes.Elems, types.Int64{Value: option.Votes})
+	}
+
+	return data
+}
+
+// setTweetV2 copies the fields that are only available through the v2 API to
+// the state. The options and duration of the configured polls are kept, only
+// their computed attributes are refreshed. Without configured polls, the poll
+// of the tweet is read as a whole, as happens on import.
+func (d *tweetResourceData) setTweetV2(tweet *tweetV2, polls []tweetPollData) {
+	d.ReplySettings = types.String{Value: tweet.ReplySettings}
+
+	if tweet.ReplySettings == "" {
+		d.ReplySettings.Value = replySettingsEveryone
+	}
+
+	d.EditableUntil = types.String{Null: true}
+	d.EditsRemaining = types.Int64{Null: true}
+
+	if tweet.EditControls != nil {
+		d.EditableUntil = types.String{Value: tweet.EditControls.EditableUntil}
+		d.EditsRemaining = types.Int64{Value: tweet.EditControls.EditsRemaining}
+
+		if !tweet.EditControls.IsEditEligible {
+			d.EditsRemaining.Value = 0
+		}
+	}
+	d.Poll = []tweetPollData{}
+
+	if len(polls) == 0 && tweet.Poll != nil {
+		d.Poll = append(d.Poll, newTweetPollData(tweet.Poll))
+	}
+
+	for _, poll := range polls {
+		pollData := newTweetPollData(tweet.Poll)
+		pollData.Options = poll.Options
+		pollData.DurationMinutes = poll.DurationMinutes
+
+		d.Poll = append(d.Poll, pollData)
+	}
+}
+
+// usesTweetV2 reports whether the tweet has fields that are only available
+// through the v2 API: a poll, reply settings or edits. Other tweets are not
+// looked up through the v2 API, so they can be managed with credentials that
+// only have access to the v1.1 API.
+func (d *tweetResourceData) usesTweetV2() bool {
+	return len(d.Poll) > 0 ||
+		(!d.ReplySettings.Null && !d.ReplySettings.Unknown) ||
+		d.EditStrategy.Value == editStrategyEdit
+}
+
+// keepTweetV2 copies the fields that are only available through the v2 API
+// from the previous state, when the tweet is not looked up through it.
+func (d *tweetResourceData) keepTweetV2(state *tweetResourceData) {
+	d.ReplySettings = state.ReplySettings
+	d.EditableUntil = state.EditableUntil
+	d.EditsRemaining = state.EditsRemaining
+	d.Poll = state.Poll
+}
+
+// newTweetV2Request builds the body of the v2 create Tweet endpoint from the
+// plan. Media must have been uploaded already.
+func newTweetV2Request(ctx context.Context, data *tweetResourceData) (tweetV2Request, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tweet := tweetV2Request{
+		Text: data.Text.Value,
+	}
+
+	if !data.ReplySettings.Null && !data.ReplySettings.Unknown && data.ReplySettings.Value != replySettingsEveryone {
+		tweet.ReplySettings = data.ReplySettings.Value
+	}
+
+	if !data.InReplyToStatusID.Null && !data.InReplyToStatusID.Unknown {
+		tweet.Reply = &tweetV2ReplyRequest{
+			InReplyToTweetID: strconv.FormatInt(data.InReplyToStatusID.Value, 10),
+		}
+	}
+
+	if !data.QuoteTweetID.Null && !data.QuoteTweetID.Unknown {
+		tweet.QuoteTweetID = strconv.FormatInt(data.QuoteTweetID.Value, 10)
+	}
+
+	for _, media := range data.Media {
+		if tweet.Media == nil {
+			tweet.Media = &tweetV2MediaRequest{}
+		}
+		tweet.Media.MediaIDs = append(tweet.Media.MediaIDs, strconv.FormatInt(media.MediaID.Value, 10))
+	}
+
+	for _, location := range data.Location {
+		if !location.PlaceID.Null {
+			tweet.Geo = &tweetV2GeoRequest{
+				PlaceID: location.PlaceID.Value,
+			}
+		}
+	}
+
+	for _, poll := range data.Poll {
+		tweet.Poll = &tweetV2PollRequest{
+			DurationMinutes: poll.DurationMinutes.Value,
+		}
+
+		diags.Append(poll.Options.ElementsAs(ctx, &tweet.Poll.Options, false)...)
+	}
+
+	return tweet, diags
+}
+
+// tweetText returns the text of a tweet as it was posted. The API returns the
+// text HTML escaped, with URLs shortened to t.co links and with the link of
+// any attached media appended, which is reverted using the entities of the
+// tweet. The tweet must have been read in extended mode with its entities.
+// When autoPopulated is set, the mentions prepended to the text of an auto
+// populated reply are removed as well. Every link is replaced with the URL as
+// it was typed in previous, the text known so far, if any.
+func tweetText(tweet *twitter.Tweet, autoPopulated bool, previous string) string {
+	text := tweet.FullText
+	if text == "" {
+		text = tweet.Text
+	}
+
+	// The indices of the entities and the display range refer to code
+	// points of the unescaped text.
+	runes := []rune(html.UnescapeString(text))
+
+	start, end := 0, len(runes)
+
+	if tweet.DisplayTextRange[1] > 0 && tweet.DisplayTextRange[1] < end {
+		end = tweet.DisplayTextRange[1]
+	}
+
+	if autoPopulated && tweet.DisplayTextRange[0] < end {
+		start = tweet.DisplayTextRange[0]
+	}
+
+	var urls []twitter.URLEntity
+	if tweet.Entities != nil {
+		urls = append(urls, tweet.Entities.Urls...)
+	}
+
+	sort.Slice(urls, func(i, j int) bool {
+		return urls[i].Indices[0] < urls[j].Indices[0]
+	})
+
+	var b strings.Builder
+
+	for _, url := range urls {
+		if url.ExpandedURL == "" || url.Indices[0] < start || url.Indices[1] > end {
+			continue
+		}
+
+		b.WriteString(string(runes[start:url.Indices[0]]))
+		b.WriteString(typedURL(url, b.String(), previous))
+		start = url.Indices[1]
+	}
+
+	b.WriteString(string(runes[start:end]))
+
+	return b.String()
+}
+
+// typedURL returns the URL of a link as it was typed, which is the URL that
+// follows prefix in previous. Twitter expands a domain typed without a scheme
+// to an http URL, so when previous does not tell, such a URL is returned as
+// the domain that is displayed.
+func typedURL(url twitter.URLEntity, prefix string, previous string) string {
+	candidates := []string{
+		url.ExpandedURL,
+		strings.TrimPrefix(url.ExpandedURL, "http://"),
+		strings.TrimPrefix(url.ExpandedURL, "https://"),
+		url.DisplayURL,
+	}
+
+	for _, candidate := range candidates {
+		if candidate != "" && strings.HasPrefix(previous, prefix+candidate) {
+			return candidate
+		}
+	}
+
+	if url.ExpandedURL == "http://"+url.DisplayURL {
+		return url.DisplayURL
+	}
+
+	return url.ExpandedURL
+}
+
+// isEditable reports whether the tweet in state can still be edited, based on
+// the edit controls read through the v2 API.
+func isEditable(state *tweetResourceData) bool {
+	if len(state.Poll) > 0 || state.EditableUntil.Null || state.EditsRemaining.Value <= 0 {
+		return false
+	}
+
+	editableUntil, err := time.Parse(time.RFC3339, state.EditableUntil.Value)
+	if err != nil {
+		return false
+	}
+
+	return time.Now().Before(editableUntil)
+}
+
+// quoteTweetURL returns the permalink of a tweet, which is used as the
+// attachment_url of a quote tweet.
+func quoteTweetURL(id int64) string {
+	return fmt.Sprintf("https://twitter.com/i/web/status/%d", id)
 }
 
 type tweetResource struct {
@@ -145,7 +722,90 @@ func (t tweetResource) Create(ctx context.Context, req tfsdk.CreateResourceReque
 		TrimUser: twitter.Bool(true),
 	}
 
-	tweet, _, err := t.provider.client.Statuses.Update(data.Text.Value, params)
+	if !data.InReplyToStatusID.Null && !data.InReplyToStatusID.Unknown {
+		params.InReplyToStatusID = data.InReplyToStatusID.Value
+	}
+
+	if !data.AutoPopulate.Null {
+		params.AutoPopulateReplyMetadata = twitter.Bool(data.AutoPopulate.Value)
+	}
+
+	if !data.QuoteTweetID.Null && !data.QuoteTweetID.Unknown {
+		params.AttachmentURL = quoteTweetURL(data.QuoteTweetID.Value)
+	}
+
+	for _, location := range data.Location {
+		if !location.Latitude.Null && !location.Longitude.Null {
+			params.Lat = twitter.Float(location.Latitude.Value)
+			params.Long = twitter.Float(location.Longitude.Value)
+		}
+
+		if !location.PlaceID.Null {
+			params.PlaceID = location.PlaceID.Value
+		}
+
+		if !location.DisplayCoordinates.Null {
+			params.DisplayCoordinates = twitter.Bool(location.DisplayCoordinates.Value)
+		}
+	}
+
+	for i, media := range data.Media {
+		hash, err := fileHash(media.File.Value)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Could not upload media",
+				fmt.Sprintf("Unable to read %s, got error %s", media.File.Value, err),
+			)
+			return
+		}
+
+		mediaID, err := uploadMedia(ctx, &t.provider.httpClient, media.File.Value)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Could not upload media",
+				fmt.Sprintf("Unable to upload %s, got error %s", media.File.Value, err),
+			)
+			return
+		}
+
+		if !media.AltText.Null && media.AltText.Value != "" {
+			err = createMediaMetadata(ctx, &t.provider.httpClient, mediaID, media.AltText.Value)
+
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Could not upload media",
+					fmt.Sprintf("Unable to set the alt text of %s, got error %s", media.File.Value, err),
+				)
+				return
+			}
+		}
+
+		data.Media[i].FileHash = types.String{Value: hash}
+		data.Media[i].MediaID = types.Int64{Value: mediaID}
+		params.MediaIds = append(params.MediaIds, mediaID)
+	}
+
+	var tweet *twitter.Tweet
+	var id int64
+
+	if len(data.Poll) > 0 || (!data.ReplySettings.Null && !data.ReplySettings.Unknown) {
+		body, diags := newTweetV2Request(ctx, &data)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		id, tweet, err = t.createTweetV2(ctx, body, &resp.Diagnostics)
+	} else {
+		tweet, _, err = t.provider.client.Statuses.Update(data.Text.Value, params)
+
+		if err == nil {
+			id = tweet.ID
+		}
+	}
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -155,21 +815,42 @@ func (t tweetResource) Create(ctx context.Context, req tfsdk.CreateResourceReque
 		return
 	}
 
-	newTweet := &tweetResourceData{}
+	var newTweet *tweetResourceData
 
-	newTweet.ID.Value = tweet.ID
-	newTweet.Text.Value = tweet.Text
-	newTweet.UserID.Value = tweet.User.ID
-	newTweet.Source.Value = tweet.Source
-	newTweet.InReplyToStatusID.Value = tweet.InReplyToStatusID
-	newTweet.InReplyToUserID.Value = tweet.InReplyToUserID
-	newTweet.QuotedStatusID.Value = tweet.QuotedStatusID
-	newTweet.QuoteCount.Value = int64(tweet.QuoteCount)
-	newTweet.ReplyCount.Value = int64(tweet.ReplyCount)
-	newTweet.RetweetCount.Value = int64(tweet.RetweetCount)
-	newTweet.FavoriteCount.Value = int64(tweet.FavoriteCount)
-	newTweet.PossiblySensitive.Value = tweet.PossiblySensitive
-	newTweet.Lang.Value = tweet.Lang
+	if tweet != nil {
+		newTweet = newTweetResourceData(tweet)
+	} else {
+		// A new tweet has no engagement yet.
+		newTweet = newPostedTweetResourceData(id, &data)
+		newTweet.QuoteCount = types.Int64{Value: 0}
+		newTweet.ReplyCount = types.Int64{Value: 0}
+		newTweet.RetweetCount = types.Int64{Value: 0}
+		newTweet.FavoriteCount = types.Int64{Value: 0}
+	}
+
+	newTweet.Text = data.Text
+	newTweet.EditStrategy = data.EditStrategy
+	newTweet.AutoPopulate = data.AutoPopulate
+	newTweet.QuoteTweetID = data.QuoteTweetID
+	newTweet.Media = data.Media
+	newTweet.Location = data.Location
+
+	if data.usesTweetV2() {
+		lookup, err := lookupTweetV2(ctx, &t.provider.httpClient, id)
+
+		if err != nil {
+			// The tweet has been posted, so the state is saved anyway and
+			// the fields only available through the v2 API are refreshed
+			// on the next read.
+			resp.Diagnostics.AddWarning(
+				"Could not read tweet",
+				fmt.Sprintf("Unable to read tweet %d through the v2 API, got error %s", id, err),
+			)
+			lookup = &tweetV2{ReplySettings: data.ReplySettings.Value}
+		}
+
+		newTweet.setTweetV2(lookup, data.Poll)
+	}
 
 	diags = resp.State.Set(ctx, &newTweet)
 	resp.Diagnostics.Append(diags...)
@@ -194,7 +875,8 @@ func (r tweetResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest,
 		ID:               data.ID.Value,
 		TrimUser:         twitter.Bool(true),
 		IncludeMyRetweet: twitter.Bool(false),
-		IncludeEntities:  twitter.Bool(false),
+		IncludeEntities:  twitter.Bool(true),
+		TweetMode:        "extended",
 	}
 
 	tweet, response, err := r.provider.client.Statuses.Show(data.ID.Value, params)
@@ -212,32 +894,345 @@ func (r tweetResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest,
 		}
 	}
 
-	newTweet := &tweetResourceData{}
+	newTweet := newTweetResourceData(tweet)
+	newTweet.Text.Value = tweetText(tweet, data.AutoPopulate.Value, data.Text.Value)
+	newTweet.EditStrategy = data.EditStrategy
+	newTweet.AutoPopulate = data.AutoPopulate
+	newTweet.QuoteTweetID = data.QuoteTweetID
+	newTweet.Media = data.Media
+	newTweet.Location = data.Location
 
-	newTweet.ID.Value = tweet.ID
-	newTweet.Text.Value = tweet.Text
-	newTweet.UserID.Value = tweet.User.ID
-	newTweet.Source.Value = tweet.Source
-	newTweet.InReplyToStatusID.Value = tweet.InReplyToStatusID
-	newTweet.InReplyToUserID.Value = tweet.InReplyToUserID
-	newTweet.QuotedStatusID.Value = tweet.QuotedStatusID
-	newTweet.QuoteCount.Value = int64(tweet.QuoteCount)
-	newTweet.ReplyCount.Value = int64(tweet.ReplyCount)
-	newTweet.RetweetCount.Value = int64(tweet.RetweetCount)
-	newTweet.FavoriteCount.Value = int64(tweet.FavoriteCount)
-	newTweet.PossiblySensitive.Value = tweet.PossiblySensitive
-	newTweet.Lang.Value = tweet.Lang
+	if !r.provider.refreshMetrics {
+		newTweet.QuoteCount = data.QuoteCount
+		newTweet.ReplyCount = data.ReplyCount
+		newTweet.RetweetCount = data.RetweetCount
+		newTweet.FavoriteCount = data.FavoriteCount
+	}
+
+	newTweet.keepTweetV2(&data)
+
+	if data.usesTweetV2() {
+		lookup, err := lookupTweetV2(ctx, &r.provider.httpClient, data.ID.Value)
+
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Could not read tweet",
+				fmt.Sprintf("Unable to read tweet %d through the v2 API, got error: %s. The poll, reply settings and edit controls keep their previous values.", data.ID.Value, err),
+			)
+		} else {
+			newTweet.setTweetV2(lookup, data.Poll)
+		}
+	}
 
 	diags = resp.State.Set(ctx, &newTweet)
 	resp.Diagnostics.Append(diags...)
 }
 
+// createTweetV2 posts the tweet through the v2 API, which is required for
+// polls, reply settings and edits, and reads it back through the v1.1 API to
+// populate the state. It returns the ID of the posted tweet, and the tweet
+// itself unless it could not be read back, in which case a warning is added
+// so that the tweet is still saved to the state.
+func (t tweetResource) createTweetV2(ctx context.Context, body tweetV2Request, diags *diag.Diagnostics) (int64, *twitter.Tweet, error) {
+	id, err := createTweetV2(ctx, &t.provider.httpClient, body)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	params := &twitter.StatusShowParams{
+		ID:               id,
+		TrimUser:         twitter.Bool(true),
+		IncludeMyRetweet: twitter.Bool(false),
+		IncludeEntities:  twitter.Bool(true),
+		TweetMode:        "extended",
+	}
+
+	tweet, _, err := t.provider.client.Statuses.Show(id, params)
+
+	if err != nil {
+		diags.AddWarning(
+			"Could not read tweet",
+			fmt.Sprintf("Tweet %d was posted, but could not be read back, got error %s. Its attributes are refreshed on the next read.", id, err),
+		)
+		return id, nil, nil
+	}
+
+	return id, tweet, nil
+}
+
+func (r tweetResource) ValidateConfig(ctx context.Context, req tfsdk.ValidateResourceConfigRequest, resp *tfsdk.ValidateResourceConfigResponse) {
+	var data tweetResourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tweets with polls or reply settings are posted through the v2 API,
+	// where polls are mutually exclusive with media and quotes, and only
+	// places can be used to geotag a Tweet.
+	for i := range data.Poll {
+		path := tftypes.NewAttributePath().WithAttributeName("poll").WithElementKeyInt(i)
+
+		if len(data.Media) > 0 {
+			resp.Diagnostics.AddAttributeError(
+				path,
+				"Invalid poll",
+				"A Tweet with a poll cannot have media.",
+			)
+		}
+
+		if !data.QuoteTweetID.Null {
+			resp.Diagnostics.AddAttributeError(
+				path,
+				"Invalid poll",
+				"A Tweet with a poll cannot quote another Tweet.",
+			)
+		}
+	}
+
+	// A GIF or a video must be the only media of a Tweet. Files that cannot
+	// be read are reported when the plan is computed, and unsupported files
+	// when they are uploaded.
+	if len(data.Media) > 1 {
+		for i, media := range data.Media {
+			if media.File.Unknown || media.File.Null {
+				continue
+			}
+
+			category, err := fileMediaCategory(media.File.Value)
+
+			if err == nil && (category == "tweet_gif" || category == "tweet_video") {
+				resp.Diagnostics.AddAttributeError(
+					tftypes.NewAttributePath().WithAttributeName("media").WithElementKeyInt(i).WithAttributeName("file"),
+					"Invalid media",
+					"A GIF or a video cannot be attached to a Tweet with other media.",
+				)
+			}
+		}
+	}
+
+	if len(data.Poll) > 0 || !data.ReplySettings.Null {
+		if data.AutoPopulate.Value {
+			resp.Diagnostics.AddAttributeError(
+				tftypes.NewAttributePath().WithAttributeName("auto_populate_reply_metadata"),
+				"Invalid reply metadata",
+				"A Tweet with a poll or reply settings is posted through the v2 API, which does not support auto_populate_reply_metadata.",
+			)
+		}
+
+		for i, location := range data.Location {
+			path := tftypes.NewAttributePath().WithAttributeName("location").WithElementKeyInt(i)
+
+			if !location.Latitude.Null || !location.Longitude.Null {
+				resp.Diagnostics.AddAttributeError(
+					path,
+					"Invalid location",
+					"A Tweet with a poll or reply settings is posted through the v2 API and can only be geotagged with a place_id.",
+				)
+			}
+
+			if location.DisplayCoordinates.Value {
+				resp.Diagnostics.AddAttributeError(
+					path.WithAttributeName("display_coordinates"),
+					"Invalid location",
+					"A Tweet with a poll or reply settings is posted through the v2 API, which does not support display_coordinates.",
+				)
+			}
+		}
+	}
+
+	for i, location := range data.Location {
+		if location.Latitude.Unknown || location.Longitude.Unknown || location.PlaceID.Unknown {
+			continue
+		}
+
+		path := tftypes.NewAttributePath().WithAttributeName("location").WithElementKeyInt(i)
+
+		if location.Latitude.Null != location.Longitude.Null {
+			resp.Diagnostics.AddAttributeError(
+				path,
+				"Invalid location",
+				"Both latitude and longitude must be set to geotag a Tweet with coordinates.",
+			)
+		}
+
+		if location.Latitude.Null && location.Longitude.Null && location.PlaceID.Null {
+			resp.Diagnostics.AddAttributeError(
+				path,
+				"Invalid location",
+				"Either latitude and longitude or place_id must be set.",
+			)
+		}
+	}
+}
+
+func (r tweetResource) ModifyPlan(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data tweetResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state tweetResourceData
+
+	if !req.State.Raw.IsNull() {
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !req.State.Raw.IsNull() && (data.Text.Unknown || data.Text.Value != state.Text.Value) {
+		textPath := tftypes.NewAttributePath().WithAttributeName("text")
+
+		if data.EditStrategy.Value == editStrategyEdit && isEditable(&state) {
+			// An edit posts a new version of the tweet, with a new ID.
+			diags = resp.Plan.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("id"), types.Int64{Unknown: true})
+			resp.Diagnostics.Append(diags...)
+		} else {
+			resp.RequiresReplace = append(resp.RequiresReplace, textPath)
+
+			if data.EditStrategy.Value == editStrategyEdit {
+				resp.Diagnostics.AddAttributeWarning(
+					textPath,
+					"Tweet cannot be edited",
+					fmt.Sprintf("Tweet %d cannot be edited anymore, because its edit window has expired, it has no edits remaining or it has a poll. The tweet will be replaced instead.", state.ID.Value),
+				)
+			}
+		}
+	}
+
+	// The hash of every file is computed at plan time, so a change to the
+	// contents of a file is planned as a replacement of the tweet.
+	for i, media := range data.Media {
+		if media.File.Unknown || media.File.Null {
+			continue
+		}
+
+		path := tftypes.NewAttributePath().WithAttributeName("media").WithElementKeyInt(i)
+
+		hash, err := fileHash(media.File.Value)
+
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.WithAttributeName("file"),
+				"Could not read media file",
+				fmt.Sprintf("Unable to read %s, got error %s", media.File.Value, err),
+			)
+			continue
+		}
+
+		hashPath := path.WithAttributeName("file_hash")
+
+		diags = resp.Plan.SetAttribute(ctx, hashPath, types.String{Value: hash})
+		resp.Diagnostics.Append(diags...)
+
+		if i < len(state.Media) && !state.Media[i].FileHash.Null && state.Media[i].FileHash.Value != hash {
+			resp.RequiresReplace = append(resp.RequiresReplace, hashPath)
+		}
+	}
+}
+
 func (r tweetResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
-	resp.Diagnostics.AddError(
-		"Update not supported",
-		"Update is not supported for tweet resource",
-	)
-	return
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, r.provider.configured)
+	if err != nil {
+		return
+	}
+
+	var data tweetResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	var state tweetResourceData
+
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Any other change replaces the tweet, so when the text is unchanged only
+	// the edit strategy has to be updated.
+	if data.Text.Value == state.Text.Value {
+		state.EditStrategy = data.EditStrategy
+
+		diags = resp.State.Set(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// The edited version is posted with the whole tweet, otherwise the reply
+	// settings, location and reply target of the previous version are lost.
+	body, diags := newTweetV2Request(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body.EditOptions = &tweetV2EditOptions{
+		PreviousPostID: strconv.FormatInt(state.ID.Value, 10),
+	}
+
+	id, tweet, err := r.createTweetV2(ctx, body, &resp.Diagnostics)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not edit tweet",
+			fmt.Sprintf("Unable to edit tweet %d, got error %s", state.ID.Value, err),
+		)
+		return
+	}
+
+	var newTweet *tweetResourceData
+
+	if tweet != nil {
+		newTweet = newTweetResourceData(tweet)
+	} else {
+		newTweet = newPostedTweetResourceData(id, &data)
+	}
+
+	newTweet.Text = data.Text
+	newTweet.EditStrategy = data.EditStrategy
+	newTweet.AutoPopulate = data.AutoPopulate
+	newTweet.QuoteTweetID = data.QuoteTweetID
+	newTweet.Media = data.Media
+	newTweet.Location = data.Location
+
+	// The engagement of the tweet is carried over to the edited version.
+	newTweet.QuoteCount = state.QuoteCount
+	newTweet.ReplyCount = state.ReplyCount
+	newTweet.RetweetCount = state.RetweetCount
+	newTweet.FavoriteCount = state.FavoriteCount
+
+	lookup, err := lookupTweetV2(ctx, &r.provider.httpClient, id)
+
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Could not read tweet",
+			fmt.Sprintf("Unable to read tweet %d through the v2 API, got error %s", id, err),
+		)
+		lookup = &tweetV2{ReplySettings: state.ReplySettings.Value}
+	}
+
+	newTweet.setTweetV2(lookup, data.Poll)
+
+	diags = resp.State.Set(ctx, &newTweet)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r tweetResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
@@ -271,3 +1266,56 @@ func (r tweetResource) Delete(ctx context.Context, req tfsdk.DeleteResourceReque
 
 	resp.State.RemoveResource(ctx)
 }
+
+func (r tweetResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, r.provider.configured)
+	if err != nil {
+		return
+	}
+
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not import tweet",
+			fmt.Sprintf("Tweet ID must be an integer, got: %q", req.ID),
+		)
+		return
+	}
+
+	params := &twitter.StatusShowParams{
+		ID:               id,
+		TrimUser:         twitter.Bool(true),
+		IncludeMyRetweet: twitter.Bool(false),
+		IncludeEntities:  twitter.Bool(true),
+		TweetMode:        "extended",
+	}
+
+	tweet, _, err := r.provider.client.Statuses.Show(id, params)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not import tweet",
+			fmt.Sprintf("Unable to read tweet with ID %d, got error: %s", id, err),
+		)
+		return
+	}
+
+	newTweet := newTweetResourceData(tweet)
+
+	// The configuration is unknown on import, so the tweet is looked up
+	// through the v2 API in case it has a poll or reply settings.
+	lookup, err := lookupTweetV2(ctx, &r.provider.httpClient, id)
+
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Could not read tweet",
+			fmt.Sprintf("Unable to read tweet with ID %d through the v2 API, got error: %s. The poll, reply settings and edit controls of the tweet are not imported.", id, err),
+		)
+	} else {
+		newTweet.setTweetV2(lookup, nil)
+	}
+
+	diags := resp.State.Set(ctx, newTweet)
+	resp.Diagnostics.Append(diags...)
+}
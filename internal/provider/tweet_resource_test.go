@@ -1,9 +1,16 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
 	"testing"
 
+	"github.com/dghubble/go-twitter/twitter"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"k8s.io/apimachinery/pkg/util/rand"
 )
@@ -26,6 +33,12 @@ func TestAccTweetResource(t *testing.T) {
 				),
 				Destroy: false,
 			},
+			// Import the tweet by ID
+			{
+				ResourceName:      "twitter_tweet.acc",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccTweetResourceConfig(modifiedText),
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -39,6 +52,494 @@ func TestAccTweetResource(t *testing.T) {
 	})
 }
 
+func TestAccTweetResourceTextNormalization(t *testing.T) {
+	// The API returns URLs shortened to t.co links, expands domains typed
+	// without a scheme and HTML escapes the text, which must not cause a
+	// diff after the tweet is read back.
+	tweetText := fmt.Sprintf("%s & <Terraform> https://www.terraform.io/ terraform.io", rand.String(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTweetResourceConfig(tweetText),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("twitter_tweet.acc", "text", tweetText),
+				),
+			},
+			{
+				Config:   testAccTweetResourceConfig(tweetText),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      "twitter_tweet.acc",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestTweetText(t *testing.T) {
+	testCases := []struct {
+		name     string
+		text     string
+		urls     []twitter.URLEntity
+		previous string
+		expected string
+	}{
+		{
+			name:     "URL with a scheme",
+			text:     "Docs https://t.co/abcdefghij",
+			urls:     []twitter.URLEntity{{ExpandedURL: "https://www.terraform.io/", DisplayURL: "terraform.io", Indices: twitter.Indices{5, 28}}},
+			previous: "Docs https://www.terraform.io/",
+			expected: "Docs https://www.terraform.io/",
+		},
+		{
+			name:     "domain without a scheme",
+			text:     "Docs https://t.co/abcdefghij",
+			urls:     []twitter.URLEntity{{ExpandedURL: "http://terraform.io", DisplayURL: "terraform.io", Indices: twitter.Indices{5, 28}}},
+			previous: "Docs terraform.io",
+			expected: "Docs terraform.io",
+		},
+		{
+			name:     "http URL",
+			text:     "Docs https://t.co/abcdefghij",
+			urls:     []twitter.URLEntity{{ExpandedURL: "http://terraform.io", DisplayURL: "terraform.io", Indices: twitter.Indices{5, 28}}},
+			previous: "Docs http://terraform.io",
+			expected: "Docs http://terraform.io",
+		},
+		{
+			name: "imported domain without a scheme",
+			text: "Docs https://t.co/abcdefghij &amp; https://t.co/klmnopqrst",
+			urls: []twitter.URLEntity{
+				{ExpandedURL: "http://terraform.io", DisplayURL: "terraform.io", Indices: twitter.Indices{5, 28}},
+				{ExpandedURL: "https://www.terraform.io/docs", DisplayURL: "terraform.io/docs", Indices: twitter.Indices{31, 54}},
+			},
+			expected: "Docs terraform.io & https://www.terraform.io/docs",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			tweet := &twitter.Tweet{
+				FullText: tc.text,
+				Entities: &twitter.Entities{Urls: tc.urls},
+			}
+
+			if text := tweetText(tweet, false, tc.previous); text != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, text)
+			}
+		})
+	}
+}
+
+func TestTweetResourceReadRefreshMetrics(t *testing.T) {
+	testCases := []struct {
+		name           string
+		refreshMetrics bool
+		expected       int64
+	}{
+		{name: "enabled", refreshMetrics: true, expected: 10},
+		{name: "disabled", refreshMetrics: false, expected: 5},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			p := testProvider(func(req *http.Request) (*http.Response, error) {
+				if req.URL.Path != "/1.1/statuses/show.json" {
+					t.Errorf("unexpected request %s %s", req.Method, req.URL)
+				}
+				return testJSONResponse(http.StatusOK, `{"id":1,"full_text":"Hello","user":{"id":2},"quote_count":10,"reply_count":10,"retweet_count":10,"favorite_count":10}`), nil
+			})
+			p.refreshMetrics = tc.refreshMetrics
+
+			r := tweetResource{provider: p}
+
+			data := newTweetResourceData(&twitter.Tweet{
+				ID:            1,
+				FullText:      "Hello",
+				User:          &twitter.User{ID: 2},
+				QuoteCount:    5,
+				ReplyCount:    5,
+				RetweetCount:  5,
+				FavoriteCount: 5,
+			})
+			state := testState(t, tweetResourceType{}, data)
+
+			req := tfsdk.ReadResourceRequest{State: state}
+			resp := &tfsdk.ReadResourceResponse{State: state}
+
+			r.Read(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics %v", resp.Diagnostics)
+			}
+
+			var newData tweetResourceData
+			diags := resp.State.Get(context.Background(), &newData)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics %v", diags)
+			}
+
+			counts := map[string]int64{
+				"quote_count":    newData.QuoteCount.Value,
+				"reply_count":    newData.ReplyCount.Value,
+				"retweet_count":  newData.RetweetCount.Value,
+				"favorite_count": newData.FavoriteCount.Value,
+			}
+			for name, count := range counts {
+				if count != tc.expected {
+					t.Errorf("expected %s to be %d, got %d", name, tc.expected, count)
+				}
+			}
+		})
+	}
+}
+
+func TestAccTweetResourceRefreshMetricsDisabled(t *testing.T) {
+	tweetText := rand.String(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTweetResourceRefreshMetricsDisabledConfig(tweetText),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("twitter_tweet.acc", "text", tweetText),
+					resource.TestCheckResourceAttr("twitter_tweet.acc", "favorite_count", "0"),
+					resource.TestCheckResourceAttr("twitter_tweet.acc", "retweet_count", "0"),
+				),
+			},
+			{
+				Config:   testAccTweetResourceRefreshMetricsDisabledConfig(tweetText),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccTweetResourceEdit(t *testing.T) {
+	tweetText := rand.String(5)
+	editedText := rand.String(5)
+
+	var tweetID string
+	var editsRemaining int
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTweetResourceEditConfig(tweetText, "edit"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("twitter_tweet.acc", "text", tweetText),
+					resource.TestCheckResourceAttr("twitter_tweet.acc", "edit_strategy", "edit"),
+					resource.TestCheckResourceAttrSet("twitter_tweet.acc", "editable_until"),
+					resource.TestCheckResourceAttrWith("twitter_tweet.acc", "id", func(value string) error {
+						tweetID = value
+						return nil
+					}),
+					resource.TestCheckResourceAttrWith("twitter_tweet.acc", "edits_remaining", func(value string) (err error) {
+						editsRemaining, err = strconv.Atoi(value)
+						return err
+					}),
+				),
+			},
+			{
+				Config: testAccTweetResourceEditConfig(editedText, "edit"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("twitter_tweet.acc", "text", editedText),
+					// Editing posts a new version of the tweet with its own ID.
+					resource.TestCheckResourceAttrWith("twitter_tweet.acc", "id", func(value string) error {
+						if value == tweetID {
+							return fmt.Errorf("expected the edited tweet to have a new ID, got %s", value)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttrWith("twitter_tweet.acc", "edits_remaining", func(value string) error {
+						if value != strconv.Itoa(editsRemaining-1) {
+							return fmt.Errorf("expected %d edits remaining, got %s", editsRemaining-1, value)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTweetResourceReply(t *testing.T) {
+	parentText := rand.String(5)
+	replyText := rand.String(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTweetResourceReplyConfig(parentText, replyText),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("twitter_tweet.reply", "text", replyText),
+					resource.TestCheckResourceAttrPair("twitter_tweet.reply", "in_reply_to_status_id", "twitter_tweet.parent", "id"),
+					resource.TestCheckResourceAttrPair("twitter_tweet.reply", "in_reply_to_user_id", "twitter_tweet.parent", "user_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTweetResourceQuote(t *testing.T) {
+	quotedText := rand.String(5)
+	quoteText := rand.String(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTweetResourceQuoteConfig(quotedText, quoteText),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("twitter_tweet.quote", "text", quoteText),
+					resource.TestCheckResourceAttrPair("twitter_tweet.quote", "quote_tweet_id", "twitter_tweet.quoted", "id"),
+					resource.TestCheckResourceAttrPair("twitter_tweet.quote", "quoted_status_id", "twitter_tweet.quoted", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTweetResourceReplySettings(t *testing.T) {
+	tweetText := rand.String(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTweetResourceReplySettingsConfig(tweetText, "following"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("twitter_tweet.acc", "text", tweetText),
+					resource.TestCheckResourceAttr("twitter_tweet.acc", "reply_settings", "following"),
+				),
+			},
+			{
+				ResourceName:      "twitter_tweet.acc",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// everyone is the default, which is omitted when posting the
+			// tweet and read back without drift
+			{
+				Config: testAccTweetResourceReplySettingsConfig(tweetText, "everyone"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("twitter_tweet.acc", "text", tweetText),
+					resource.TestCheckResourceAttr("twitter_tweet.acc", "reply_settings", "everyone"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTweetResourceLocation(t *testing.T) {
+	tweetText := rand.String(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTweetResourceLocationConfig(tweetText, "latitude = 37.7821\n    longitude = -122.4093"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("twitter_tweet.acc", "text", tweetText),
+					resource.TestCheckResourceAttr("twitter_tweet.acc", "location.0.latitude", "37.7821"),
+					resource.TestCheckResourceAttr("twitter_tweet.acc", "location.0.longitude", "-122.4093"),
+				),
+			},
+			// The place Twitter attaches to the coordinates is not imported
+			{
+				ResourceName:            "twitter_tweet.acc",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"reply_settings", "editable_until", "edits_remaining"},
+			},
+		},
+	})
+}
+
+func TestAccTweetResourcePoll(t *testing.T) {
+	tweetText := rand.String(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTweetResourcePollConfig(tweetText, `["Yes", "No"]`, 60),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("twitter_tweet.acc", "text", tweetText),
+					resource.TestCheckResourceAttr("twitter_tweet.acc", "poll.0.options.#", "2"),
+					resource.TestCheckResourceAttr("twitter_tweet.acc", "poll.0.duration_minutes", "60"),
+					resource.TestCheckResourceAttr("twitter_tweet.acc", "poll.0.votes.0", "0"),
+					resource.TestCheckResourceAttr("twitter_tweet.acc", "poll.0.voting_status", "open"),
+					resource.TestCheckResourceAttrSet("twitter_tweet.acc", "poll.0.end_datetime"),
+				),
+			},
+			{
+				ResourceName:      "twitter_tweet.acc",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTweetResourceMedia(t *testing.T) {
+	tweetText := rand.String(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTweetResourceMediaConfig(tweetText, "testdata/image.png", "A colorful gradient"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("twitter_tweet.acc", "text", tweetText),
+					resource.TestCheckResourceAttr("twitter_tweet.acc", "media.#", "1"),
+					resource.TestCheckResourceAttrSet("twitter_tweet.acc", "media.0.media_id"),
+					resource.TestCheckResourceAttrSet("twitter_tweet.acc", "media.0.file_hash"),
+					resource.TestCheckResourceAttr("twitter_tweet.acc", "media.0.alt_text", "A colorful gradient"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTweetResourceValidators(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// CJK characters count as two characters
+			{
+				Config:      testAccTweetResourceConfig(strings.Repeat("語", 141)),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("got: 282 weighted characters"),
+			},
+			// Alt text must not exceed 1000 characters
+			{
+				Config:      testAccTweetResourceMediaConfig(rand.String(5), "testdata/image.png", strings.Repeat("a", 1001)),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Length must be between 0 and 1000 characters"),
+			},
+			// A GIF cannot be attached with other media
+			{
+				Config: `
+resource "twitter_tweet" "acc" {
+  text = "GIF"
+
+  media {
+    file = "testdata/image.png"
+  }
+
+  media {
+    file = "testdata/animation.gif"
+  }
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("A GIF or a video cannot be attached to a Tweet with other media"),
+			},
+			// Latitude and longitude must be set together
+			{
+				Config:      testAccTweetResourceLocationConfig(rand.String(5), "latitude = 37.7821"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Both latitude and longitude must be set"),
+			},
+			// Latitude must be a valid coordinate
+			{
+				Config:      testAccTweetResourceLocationConfig(rand.String(5), "latitude = 91\n    longitude = 0"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Latitude must be between -90 and 90"),
+			},
+			// Place IDs are hexadecimal strings
+			{
+				Config:      testAccTweetResourceLocationConfig(rand.String(5), "place_id = \"San Francisco\""),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("The place ID must be a 16 character hexadecimal string"),
+			},
+			// Polls must have between 2 and 4 options
+			{
+				Config:      testAccTweetResourcePollConfig(rand.String(5), `["Yes"]`, 60),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Must contain between 2 and 4 options, got: 1"),
+			},
+			// Poll options must not exceed 25 characters
+			{
+				Config:      testAccTweetResourcePollConfig(rand.String(5), fmt.Sprintf(`["Yes", %q]`, rand.String(26)), 60),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Poll option length must be between 1 and 25 characters"),
+			},
+			// Polls must last at least 5 minutes
+			{
+				Config:      testAccTweetResourcePollConfig(rand.String(5), `["Yes", "No"]`, 1),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Poll duration must be between 5 and 10080 minutes"),
+			},
+			// Reply settings must be one of the values supported by the API
+			{
+				Config:      testAccTweetResourceReplySettingsConfig(rand.String(5), "followers"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Value must be one of: everyone, mentionedUsers, following"),
+			},
+			// Tweets posted through the v2 API cannot auto populate the reply metadata
+			{
+				Config: `
+resource "twitter_tweet" "acc" {
+  text                         = "Reply"
+  in_reply_to_status_id        = 1559537820804399104
+  auto_populate_reply_metadata = true
+  reply_settings               = "following"
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("which does not support auto_populate_reply_metadata"),
+			},
+			// Tweets posted through the v2 API cannot display coordinates
+			{
+				Config: `
+resource "twitter_tweet" "acc" {
+  text           = "Location"
+  reply_settings = "following"
+
+  location {
+    place_id            = "5a110d312052166f"
+    display_coordinates = true
+  }
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("which does not support display_coordinates"),
+			},
+			// Edit strategy must be either replace or edit
+			{
+				Config:      testAccTweetResourceEditConfig(rand.String(5), "update"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Value must be one of: replace, edit"),
+			},
+			// URLs count as 23 characters regardless of their length
+			{
+				Config:      testAccTweetResourceConfig(strings.Repeat("a", 257) + " https://registry.terraform.io/providers/sebastianmarines/twitter/latest"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("got: 281 weighted characters"),
+			},
+		},
+	})
+}
+
 func testAccTweetResourceConfig(text string) string {
 	return fmt.Sprintf(`
 resource "twitter_tweet" "acc" {
@@ -46,3 +547,98 @@ resource "twitter_tweet" "acc" {
 }`, text)
 
 }
+
+func testAccTweetResourceRefreshMetricsDisabledConfig(text string) string {
+	return fmt.Sprintf(`
+provider "twitter" {
+  refresh_metrics = false
+}
+
+resource "twitter_tweet" "acc" {
+  text = %[1]q
+}`, text)
+
+}
+
+func testAccTweetResourceEditConfig(text string, editStrategy string) string {
+	return fmt.Sprintf(`
+resource "twitter_tweet" "acc" {
+  text          = %[1]q
+  edit_strategy = %[2]q
+}`, text, editStrategy)
+
+}
+
+func testAccTweetResourceReplyConfig(parentText string, replyText string) string {
+	return fmt.Sprintf(`
+resource "twitter_tweet" "parent" {
+  text = %[1]q
+}
+
+resource "twitter_tweet" "reply" {
+  text                         = %[2]q
+  in_reply_to_status_id        = twitter_tweet.parent.id
+  auto_populate_reply_metadata = true
+}`, parentText, replyText)
+
+}
+
+func testAccTweetResourceQuoteConfig(quotedText string, quoteText string) string {
+	return fmt.Sprintf(`
+resource "twitter_tweet" "quoted" {
+  text = %[1]q
+}
+
+resource "twitter_tweet" "quote" {
+  text           = %[2]q
+  quote_tweet_id = twitter_tweet.quoted.id
+}`, quotedText, quoteText)
+
+}
+
+func testAccTweetResourceReplySettingsConfig(text string, replySettings string) string {
+	return fmt.Sprintf(`
+resource "twitter_tweet" "acc" {
+  text           = %[1]q
+  reply_settings = %[2]q
+}`, text, replySettings)
+
+}
+
+func testAccTweetResourceLocationConfig(text string, location string) string {
+	return fmt.Sprintf(`
+resource "twitter_tweet" "acc" {
+  text = %[1]q
+
+  location {
+    %[2]s
+  }
+}`, text, location)
+
+}
+
+func testAccTweetResourcePollConfig(text string, options string, duration int) string {
+	return fmt.Sprintf(`
+resource "twitter_tweet" "acc" {
+  text = %[1]q
+
+  poll {
+    options          = %[2]s
+    duration_minutes = %[3]d
+  }
+}`, text, options, duration)
+
+}
+
+func testAccTweetResourceMediaConfig(text string, file string, altText string) string {
+	return fmt.Sprintf(`
+resource "twitter_tweet" "acc" {
+  text = %[1]q
+
+  media {
+    file     = %[2]q
+    alt_text = %[3]q
+  }
+}`, text, file, altText)
+
+}
@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+)
+
+// tweetV2Request is the body of the v2 create Tweet endpoint, which supports
+// features that are not available through statuses/update, such as polls.
+// https://developer.twitter.com/en/docs/twitter-api/tweets/manage-tweets/api-reference/post-tweets
+type tweetV2Request struct {
+	Text          string               `json:"text"`
+	ReplySettings string               `json:"reply_settings,omitempty"`
+	Reply         *tweetV2ReplyRequest `json:"reply,omitempty"`
+	QuoteTweetID  string               `json:"quote_tweet_id,omitempty"`
+	Media         *tweetV2MediaRequest `json:"media,omitempty"`
+	Geo           *tweetV2GeoRequest   `json:"geo,omitempty"`
+	Poll          *tweetV2PollRequest  `json:"poll,omitempty"`
+	EditOptions   *tweetV2EditOptions  `json:"edit_options,omitempty"`
+}
+
+type tweetV2ReplyRequest struct {
+	InReplyToTweetID string `json:"in_reply_to_tweet_id"`
+}
+
+type tweetV2MediaRequest struct {
+	MediaIDs []string `json:"media_ids"`
+}
+
+type tweetV2GeoRequest struct {
+	PlaceID string `json:"place_id"`
+}
+
+type tweetV2PollRequest struct {
+	Options         []string `json:"options"`
+	DurationMinutes int64    `json:"duration_minutes"`
+}
+
+type tweetV2EditOptions struct {
+	PreviousPostID string `json:"previous_post_id"`
+}
+
+type tweetV2Response struct {
+	Data struct {
+		ID string `json:"id"`
+	} `json:"data"`
+}
+
+type tweetV2Poll struct {
+	ID              string              `json:"id"`
+	Options         []tweetV2PollOption `json:"options"`
+	DurationMinutes int64               `json:"duration_minutes"`
+	EndDatetime     string              `json:"end_datetime"`
+	VotingStatus    string              `json:"voting_status"`
+}
+
+type tweetV2PollOption struct {
+	Position int    `json:"position"`
+	Label    string `json:"label"`
+	Votes    int64  `json:"votes"`
+}
+
+type tweetV2 struct {
+	ID            string               `json:"id"`
+	ReplySettings string               `json:"reply_settings"`
+	EditControls  *tweetV2EditControls `json:"edit_controls"`
+
+	// Poll is filled from the includes of the lookup response.
+	Poll *tweetV2Poll `json:"-"`
+}
+
+type tweetV2EditControls struct {
+	EditsRemaining int64  `json:"edits_remaining"`
+	IsEditEligible bool   `json:"is_edit_eligible"`
+	EditableUntil  string `json:"editable_until"`
+}
+
+type tweetV2LookupResponse struct {
+	Data     tweetV2 `json:"data"`
+	Includes struct {
+		Polls []tweetV2Poll `json:"polls"`
+	} `json:"includes"`
+}
+
+// createTweetV2 posts a tweet through the v2 API and returns its ID.
+func createTweetV2(ctx context.Context, client *http.Client, tweet tweetV2Request) (int64, error) {
+	body, err := json.Marshal(tweet)
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, twitterAPIv2+"tweets", bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	var res tweetV2Response
+
+	err = doAPIRequest(client, req, &res)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseInt(res.Data.ID, 10, 64)
+}
+
+// lookupTweetV2 returns the fields of a tweet that are only available
+// through the v2 API, the options of its poll are sorted by position.
+// https://developer.twitter.com/en/docs/twitter-api/tweets/lookup/api-reference/get-tweets-id
+func lookupTweetV2(ctx context.Context, client *http.Client, id int64) (*tweetV2, error) {
+	query := url.Values{
+		"tweet.fields": {"reply_settings,edit_controls"},
+		"expansions":   {"attachments.poll_ids"},
+		"poll.fields":  {"duration_minutes,end_datetime,voting_status"},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, twitterAPIv2+"tweets/"+strconv.FormatInt(id, 10)+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res tweetV2LookupResponse
+
+	err = doAPIRequest(client, req, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	tweet := res.Data
+
+	if len(res.Includes.Polls) > 0 {
+		poll := res.Includes.Polls[0]
+
+		sort.Slice(poll.Options, func(i, j int) bool {
+			return poll.Options[i].Position < poll.Options[j].Position
+		})
+
+		tweet.Poll = &poll
+	}
+
+	return &tweet, nil
+}
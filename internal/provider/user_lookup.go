@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dghubble/go-twitter/twitter"
+)
+
+// usersLookupBatchSize is the maximum number of users that can be requested
+// at once from users/lookup.
+const usersLookupBatchSize = 100
+
+// lookupUsers resolves identifiers, which are either numeric user IDs or
+// screen names optionally prefixed with @, with as few users/lookup requests
+// as possible. The users are keyed by the identifier that matched them,
+// identifiers that don't match any user are missing from the result.
+// https://developer.twitter.com/en/docs/twitter-api/v1/accounts-and-users/follow-search-get-users/api-reference/get-users-lookup
+func lookupUsers(client *twitter.Client, identifiers []string) (map[string]twitter.User, error) {
+	users := make(map[string]twitter.User, len(identifiers))
+
+	for start := 0; start < len(identifiers); start += usersLookupBatchSize {
+		end := start + usersLookupBatchSize
+		if end > len(identifiers) {
+			end = len(identifiers)
+		}
+		batch := identifiers[start:end]
+
+		params := &twitter.UserLookupParams{
+			IncludeEntities: twitter.Bool(false),
+		}
+
+		for _, identifier := range batch {
+			if userID, ok := parseUserID(identifier); ok {
+				params.UserID = append(params.UserID, userID)
+			} else {
+				params.ScreenName = append(params.ScreenName, strings.TrimPrefix(identifier, "@"))
+			}
+		}
+
+		found, response, err := client.Users.Lookup(params)
+
+		// users/lookup responds with a 404 when none of the users exist.
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return nil, err
+		}
+
+		for _, identifier := range batch {
+			for _, user := range found {
+				if userMatches(user, identifier) {
+					users[identifier] = user
+					break
+				}
+			}
+		}
+	}
+
+	return users, nil
+}
+
+// userMatches reports whether identifier is the ID or the screen name of user.
+// Screen names are case insensitive.
+func userMatches(user twitter.User, identifier string) bool {
+	if userID, ok := parseUserID(identifier); ok {
+		return user.ID == userID
+	}
+
+	return strings.EqualFold(user.ScreenName, strings.TrimPrefix(identifier, "@"))
+}
+
+// userIdentifiers returns the identifiers that match users, keeping the ones
+// used in the configuration so that they don't show up as a difference. Users
+// that none of the configured identifiers match are identified by their
+// screen name.
+func userIdentifiers(users []twitter.User, configured []string) []string {
+	var identifiers []string
+
+	for _, user := range users {
+		matched := false
+
+		for _, identifier := range configured {
+			if userMatches(user, identifier) {
+				identifiers = append(identifiers, identifier)
+				matched = true
+			}
+		}
+
+		if !matched {
+			identifiers = append(identifiers, user.ScreenName)
+		}
+	}
+
+	return identifiers
+}
+
+// userIDBatches sorts the IDs and splits them into comma separated batches of
+// at most size IDs, as expected by the endpoints that take a list of users.
+func userIDBatches(userIDs []int64, size int) []string {
+	sorted := append([]int64(nil), userIDs...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var batches []string
+
+	for start := 0; start < len(sorted); start += size {
+		end := start + size
+		if end > len(sorted) {
+			end = len(sorted)
+		}
+
+		ids := make([]string, 0, end-start)
+		for _, id := range sorted[start:end] {
+			ids = append(ids, strconv.FormatInt(id, 10))
+		}
+
+		batches = append(batches, strings.Join(ids, ","))
+	}
+
+	return batches
+}
+
+func parseUserID(identifier string) (int64, bool) {
+	userID, err := strconv.ParseInt(identifier, 10, 64)
+	return userID, err == nil
+}
@@ -0,0 +1,306 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/dghubble/go-twitter/twitter"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sebastianmarines/terraform-provider-twitter/internal/utils"
+)
+
+var _ tfsdk.Resource = userRelationshipResource{}
+var _ tfsdk.ResourceWithImportState = userRelationshipResource{}
+
+// userRelationship describes a relationship of the authenticating user with
+// another user, such as a mute or a block, which is created and destroyed
+// through the v1.1 API and read through friendships/lookup.
+type userRelationship struct {
+	// Name is used in the diagnostics, e.g. "mute".
+	Name string
+	// Connection is the connection returned by friendships/lookup while the
+	// relationship exists, e.g. "muting".
+	Connection  string
+	CreatePath  string
+	DestroyPath string
+}
+
+// userRelationshipSchema returns the schema shared by the resources that
+// manage a userRelationship.
+func userRelationshipSchema(description string) tfsdk.Schema {
+	return tfsdk.Schema{
+		MarkdownDescription: description,
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "The ID of the user.",
+				Type:                types.Int64Type,
+				Computed:            true,
+			},
+			"screen_name": {
+				MarkdownDescription: "The screen name of the user.",
+				Type:                types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"user_id": {
+				MarkdownDescription: "The ID of the user.",
+				Type:                types.Int64Type,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+type userRelationshipResourceData struct {
+	ID         types.Int64  `tfsdk:"id"`
+	ScreenName types.String `tfsdk:"screen_name"`
+	UserId     types.Int64  `tfsdk:"user_id"`
+}
+
+type userRelationshipResource struct {
+	provider     provider
+	relationship userRelationship
+}
+
+func (t userRelationshipResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, t.provider.configured)
+	if err != nil {
+		return
+	}
+
+	var data userRelationshipResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ScreenName.Null && data.UserId.Null {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Could not %s user", t.relationship.Name),
+			"Must specify either screen_name or user_id",
+		)
+		return
+	}
+
+	var user twitter.User
+
+	err = t.post(ctx, t.relationship.CreatePath, &data, &user)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Could not %s user", t.relationship.Name),
+			fmt.Sprintf("Unable to %s user, got error %s", t.relationship.Name, err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, newUserRelationshipResourceData(user.ID, user.ScreenName))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r userRelationshipResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, r.provider.configured)
+	if err != nil {
+		return
+	}
+
+	var data userRelationshipResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	friendship, err := r.lookup(&twitter.FriendshipLookupParams{
+		UserID: []int64{data.UserId.Value},
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Could not read %s", r.relationship.Name),
+			fmt.Sprintf("Unable to read the relationship with user %d, got error %s", data.UserId.Value, err),
+		)
+		return
+	}
+
+	// The user no longer exists or the relationship has been removed
+	// outside of Terraform.
+	if friendship == nil || !hasConnection(friendship, r.relationship.Connection) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = resp.State.Set(ctx, newUserRelationshipResourceData(friendship.ID, friendship.ScreenName))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r userRelationshipResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	resp.Diagnostics.AddError(
+		"Update not supported",
+		fmt.Sprintf("Update is not supported for %s resource", r.relationship.Name),
+	)
+	return
+}
+
+func (r userRelationshipResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, r.provider.configured)
+	if err != nil {
+		return
+	}
+
+	var data userRelationshipResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err = r.post(ctx, r.relationship.DestroyPath, &userRelationshipResourceData{
+		ScreenName: types.String{Null: true},
+		UserId:     data.UserId,
+	}, nil)
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Could not un%s user", r.relationship.Name),
+			fmt.Sprintf("Unable to un%s user, got error %s", r.relationship.Name, err),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r userRelationshipResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	err := utils.CheckProviderConfiguration(&resp.Diagnostics, r.provider.configured)
+	if err != nil {
+		return
+	}
+
+	params := &twitter.FriendshipLookupParams{}
+
+	if strings.HasPrefix(req.ID, "@") {
+		params.ScreenName = []string{strings.TrimPrefix(req.ID, "@")}
+	} else {
+		userId, err := strconv.ParseInt(req.ID, 10, 64)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Could not import %s", r.relationship.Name),
+				fmt.Sprintf("Import ID must be a numeric user ID or a screen name prefixed with @, got: %q", req.ID),
+			)
+			return
+		}
+
+		params.UserID = []int64{userId}
+	}
+
+	friendship, err := r.lookup(params)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Could not import %s", r.relationship.Name),
+			fmt.Sprintf("Unable to read user %s, got error %s", req.ID, err),
+		)
+		return
+	}
+
+	if friendship == nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Could not import %s", r.relationship.Name),
+			fmt.Sprintf("User %s does not exist", req.ID),
+		)
+		return
+	}
+
+	if !hasConnection(friendship, r.relationship.Connection) {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Could not import %s", r.relationship.Name),
+			fmt.Sprintf("The authenticated user is not %s @%s", r.relationship.Connection, friendship.ScreenName),
+		)
+		return
+	}
+
+	diags := resp.State.Set(ctx, newUserRelationshipResourceData(friendship.ID, friendship.ScreenName))
+	resp.Diagnostics.Append(diags...)
+}
+
+// post sends a request to one of the endpoints of the relationship, which take
+// either the screen name or the ID of the user.
+func (r userRelationshipResource) post(ctx context.Context, path string, data *userRelationshipResourceData, v interface{}) error {
+	form := url.Values{}
+
+	if !data.ScreenName.Null && !data.ScreenName.Unknown {
+		form.Set("screen_name", data.ScreenName.Value)
+	}
+	if !data.UserId.Null && !data.UserId.Unknown {
+		form.Set("user_id", strconv.FormatInt(data.UserId.Value, 10))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, twitterAPIv1+path, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return doAPIRequest(&r.provider.httpClient, req, v)
+}
+
+// lookup returns the relationship of the authenticating user with the user
+// matching params, or nil if the user does not exist.
+func (r userRelationshipResource) lookup(params *twitter.FriendshipLookupParams) (*twitter.FriendshipResponse, error) {
+	friendships, response, err := r.provider.client.Friendships.Lookup(params)
+
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if friendships == nil || len(*friendships) == 0 {
+		return nil, nil
+	}
+
+	return &(*friendships)[0], nil
+}
+
+// hasConnection reports whether connection is one of the connections of the
+// authenticating user with the user of friendship.
+func hasConnection(friendship *twitter.FriendshipResponse, connection string) bool {
+	for _, c := range friendship.Connections {
+		if c == connection {
+			return true
+		}
+	}
+
+	return false
+}
+
+func newUserRelationshipResourceData(userID int64, screenName string) *userRelationshipResourceData {
+	return &userRelationshipResourceData{
+		ID:         types.Int64{Value: userID},
+		ScreenName: types.String{Value: screenName},
+		UserId:     types.Int64{Value: userID},
+	}
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccUserRelationshipResources(t *testing.T) {
+	for _, resourceType := range []string{"twitter_mute", "twitter_block"} {
+		resourceType := resourceType
+		resourceName := resourceType + ".acc"
+
+		t.Run(resourceType, func(t *testing.T) {
+			screenName := os.Getenv("TWITTER_ACC_TEST_SCREEN_NAME")
+
+			resource.Test(t, resource.TestCase{
+				PreCheck:                 func() { testAccPreCheckTestAccount(t) },
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					// Mute or block the test account
+					{
+						Config: testAccUserRelationshipResourceConfig(resourceType, screenName),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttrSet(resourceName, "user_id"),
+							resource.TestCheckResourceAttr(resourceName, "screen_name", screenName),
+						),
+					},
+					// Import by user ID
+					{
+						ResourceName:      resourceName,
+						ImportState:       true,
+						ImportStateIdFunc: testAccUserRelationshipResourceUserID(resourceName),
+						ImportStateVerify: true,
+					},
+					// Import by screen name
+					{
+						ResourceName:      resourceName,
+						ImportState:       true,
+						ImportStateId:     "@" + screenName,
+						ImportStateVerify: true,
+					},
+				},
+			})
+		})
+	}
+}
+
+// testAccUserRelationshipResourceUserID returns the user ID of the muted or
+// blocked user, to import it by ID.
+func testAccUserRelationshipResourceUserID(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["user_id"], nil
+	}
+}
+
+func testAccUserRelationshipResourceConfig(resourceType string, screenName string) string {
+	return fmt.Sprintf(`
+resource %[1]q "acc" {
+  screen_name = %[2]q
+}
+`, resourceType, screenName)
+}
@@ -0,0 +1,62 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type coordinateValidator struct {
+	Name string
+	Max  float64
+	Min  float64
+}
+
+func (v coordinateValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("%s must be between %g and %g.", v.Name, v.Min, v.Max)
+}
+
+func (v coordinateValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("%s must be between %g and %g.", v.Name, v.Min, v.Max)
+}
+
+func (v coordinateValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	var coordinate types.Float64
+	diags := tfsdk.ValueAs(ctx, req.AttributeConfig, &coordinate)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if coordinate.Unknown || coordinate.Null {
+		return
+	}
+
+	if coordinate.Value < v.Min || coordinate.Value > v.Max {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			fmt.Sprintf("Invalid %s", v.Name),
+			fmt.Sprintf("%s must be between %g and %g, got: %g.", v.Name, v.Min, v.Max, coordinate.Value),
+		)
+
+		return
+	}
+}
+
+func Latitude() coordinateValidator {
+	return coordinateValidator{
+		Name: "Latitude",
+		Max:  90,
+		Min:  -90,
+	}
+}
+
+func Longitude() coordinateValidator {
+	return coordinateValidator{
+		Name: "Longitude",
+		Max:  180,
+		Min:  -180,
+	}
+}
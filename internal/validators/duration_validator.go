@@ -0,0 +1,49 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type durationValidator struct{}
+
+func (v durationValidator) Description(ctx context.Context) string {
+	return "Value must be a positive duration such as 30s, 10m or 24h."
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return "Value must be a positive duration such as `30s`, `10m` or `24h`."
+}
+
+func (v durationValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	var str types.String
+	diags := tfsdk.ValueAs(ctx, req.AttributeConfig, &str)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if str.Unknown || str.Null {
+		return
+	}
+
+	duration, err := time.ParseDuration(str.Value)
+
+	if err != nil || duration <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Duration",
+			fmt.Sprintf("Value must be a positive duration such as 30s, 10m or 24h, got: %q.", str.Value),
+		)
+	}
+}
+
+// Duration validates that a string is a positive duration that can be parsed
+// by time.ParseDuration.
+func Duration() durationValidator {
+	return durationValidator{}
+}
@@ -0,0 +1,54 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type oneOfValidator struct {
+	Values []string
+}
+
+func (v oneOfValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("Value must be one of: %s.", strings.Join(v.Values, ", "))
+}
+
+func (v oneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("Value must be one of: `%s`.", strings.Join(v.Values, "`, `"))
+}
+
+func (v oneOfValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	var str types.String
+	diags := tfsdk.ValueAs(ctx, req.AttributeConfig, &str)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if str.Unknown || str.Null {
+		return
+	}
+
+	for _, value := range v.Values {
+		if str.Value == value {
+			return
+		}
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.AttributePath,
+		"Invalid Value",
+		fmt.Sprintf("Value must be one of: %s, got: %q.", strings.Join(v.Values, ", "), str.Value),
+	)
+}
+
+// OneOf validates that a string is one of the given values.
+func OneOf(values ...string) oneOfValidator {
+	return oneOfValidator{
+		Values: values,
+	}
+}
@@ -0,0 +1,49 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var placeIDRegexp = regexp.MustCompile(`^[0-9a-f]{16}$`)
+
+type placeIDValidator struct{}
+
+func (v placeIDValidator) Description(ctx context.Context) string {
+	return "The place ID must be a 16 character hexadecimal string."
+}
+
+func (v placeIDValidator) MarkdownDescription(ctx context.Context) string {
+	return "The place ID must be a 16 character hexadecimal string."
+}
+
+func (v placeIDValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	var placeID types.String
+	diags := tfsdk.ValueAs(ctx, req.AttributeConfig, &placeID)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if placeID.Unknown || placeID.Null {
+		return
+	}
+
+	if !placeIDRegexp.MatchString(placeID.Value) {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid place ID.",
+			fmt.Sprintf("The place ID must be a 16 character hexadecimal string, got: %q", placeID.Value),
+		)
+
+		return
+	}
+}
+
+func PlaceID() placeIDValidator {
+	return placeIDValidator{}
+}
@@ -0,0 +1,51 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type pollDurationValidator struct {
+	Min int64
+	Max int64
+}
+
+func (v pollDurationValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("Poll duration must be between %d and %d minutes.", v.Min, v.Max)
+}
+
+func (v pollDurationValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("Poll duration must be between %d and %d minutes.", v.Min, v.Max)
+}
+
+func (v pollDurationValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	var duration types.Int64
+	diags := tfsdk.ValueAs(ctx, req.AttributeConfig, &duration)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if duration.Unknown || duration.Null {
+		return
+	}
+
+	if duration.Value < v.Min || duration.Value > v.Max {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Poll Duration",
+			fmt.Sprintf("Poll duration must be between %d and %d minutes, got: %d.", v.Min, v.Max, duration.Value),
+		)
+	}
+}
+
+// PollDuration validates that a poll lasts between 5 minutes and 7 days.
+func PollDuration() pollDurationValidator {
+	return pollDurationValidator{
+		Min: 5,
+		Max: 7 * 24 * 60,
+	}
+}
@@ -0,0 +1,78 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type pollOptionsValidator struct {
+	MinItems  int
+	MaxItems  int
+	MaxLength int
+}
+
+func (v pollOptionsValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("Must contain between %d and %d options of up to %d characters.", v.MinItems, v.MaxItems, v.MaxLength)
+}
+
+func (v pollOptionsValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("Must contain between %d and %d options of up to %d characters.", v.MinItems, v.MaxItems, v.MaxLength)
+}
+
+func (v pollOptionsValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	var list types.List
+	diags := tfsdk.ValueAs(ctx, req.AttributeConfig, &list)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if list.Unknown || list.Null {
+		return
+	}
+
+	if len(list.Elems) < v.MinItems || len(list.Elems) > v.MaxItems {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Number of Poll Options",
+			fmt.Sprintf("Must contain between %d and %d options, got: %d.", v.MinItems, v.MaxItems, len(list.Elems)),
+		)
+
+		return
+	}
+
+	for i, elem := range list.Elems {
+		var str types.String
+		diags := tfsdk.ValueAs(ctx, elem, &str)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			continue
+		}
+
+		if str.Unknown || str.Null {
+			continue
+		}
+
+		strLen := utf8.RuneCountInString(str.Value)
+
+		if strLen < 1 || strLen > v.MaxLength {
+			resp.Diagnostics.AddAttributeError(
+				req.AttributePath.WithElementKeyInt(i),
+				"Invalid Poll Option Length",
+				fmt.Sprintf("Poll option length must be between 1 and %d characters, got: %d characters.", v.MaxLength, strLen),
+			)
+		}
+	}
+}
+
+func PollOptions() pollOptionsValidator {
+	return pollOptionsValidator{
+		MinItems:  2,
+		MaxItems:  4,
+		MaxLength: 25,
+	}
+}
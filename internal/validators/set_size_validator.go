@@ -0,0 +1,51 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type setSizeValidator struct {
+	Max int
+	Min int
+}
+
+func (v setSizeValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("Must contain between %d and %d elements.", v.Min, v.Max)
+}
+
+func (v setSizeValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("Must contain between %d and %d elements.", v.Min, v.Max)
+}
+
+func (v setSizeValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	var set types.Set
+	diags := tfsdk.ValueAs(ctx, req.AttributeConfig, &set)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if set.Unknown || set.Null {
+		return
+	}
+
+	if len(set.Elems) < v.Min || len(set.Elems) > v.Max {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Number of Elements",
+			fmt.Sprintf("Must contain between %d and %d elements, got: %d.", v.Min, v.Max, len(set.Elems)),
+		)
+	}
+}
+
+// SetSize validates that a set has between min and max elements.
+func SetSize(min int, max int) setSizeValidator {
+	return setSizeValidator{
+		Max: max,
+		Min: min,
+	}
+}
@@ -0,0 +1,54 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type stringLengthValidator struct {
+	Max int
+	Min int
+}
+
+func (v stringLengthValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("Length must be between %d and %d characters.", v.Min, v.Max)
+}
+
+func (v stringLengthValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("Length must be between %d and %d characters.", v.Min, v.Max)
+}
+
+func (v stringLengthValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	var str types.String
+	diags := tfsdk.ValueAs(ctx, req.AttributeConfig, &str)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if str.Unknown || str.Null {
+		return
+	}
+
+	strLen := utf8.RuneCountInString(str.Value)
+
+	if strLen < v.Min || strLen > v.Max {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Length",
+			fmt.Sprintf("Length must be between %d and %d characters, got: %d characters.", v.Min, v.Max, strLen),
+		)
+	}
+}
+
+// StringLength validates that a string has between min and max characters.
+func StringLength(min int, max int) stringLengthValidator {
+	return stringLengthValidator{
+		Max: max,
+		Min: min,
+	}
+}
@@ -3,22 +3,59 @@ package validators
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"golang.org/x/text/unicode/norm"
 )
 
+// Configuration of the twitter-text v3 weighted length algorithm.
+// shortURLLength is the length of a t.co link, which every URL counts as
+// regardless of its own length. It is the short_url_length reported by the
+// help/configuration endpoint, hardcoded because validators run before the
+// provider is configured and cannot call the API.
+// See https://developer.twitter.com/en/docs/counting-characters
+const (
+	maxWeightedTweetLength = 280
+	weightScale            = 100
+	defaultWeight          = 200
+	shortURLLength         = 23
+)
+
+type weightRange struct {
+	Start  rune
+	End    rune
+	Weight int
+}
+
+// weightRanges lists the code points that count as a single character. Any
+// code point outside of these ranges, such as CJK characters and emoji, is
+// counted with the default weight of two characters.
+var weightRanges = []weightRange{
+	{Start: 0x0000, End: 0x10FF, Weight: 100},
+	{Start: 0x2000, End: 0x200D, Weight: 100},
+	{Start: 0x2010, End: 0x201F, Weight: 100},
+	{Start: 0x2032, End: 0x2037, Weight: 100},
+}
+
+var urlRegexp = regexp.MustCompile(`(?i)(?:https?://|www\.)[^\s<>"]+|\b[a-z0-9][a-z0-9-]*(?:\.[a-z0-9-]+)*\.(?:com|net|org|edu|gov|io|co|dev|app|info|biz|me|tv|ly|gl|gg|ai)\b(?:/[^\s<>"]*)?`)
+
 type tweetLengthValidator struct {
-	Max int
-	Min int
+	Max            int
+	Min            int
+	ShortURLLength int
 }
 
 func (v tweetLengthValidator) Description(ctx context.Context) string {
-	return fmt.Sprintf("Tweet length must be between %d and %d characters.", v.Min, v.Max)
+	return fmt.Sprintf("Weighted tweet length must be between %d and %d characters.", v.Min, v.Max)
 }
 
 func (v tweetLengthValidator) MarkdownDescription(ctx context.Context) string {
-	return fmt.Sprintf("Tweet length must be between %d and %d characters.", v.Min, v.Max)
+	return fmt.Sprintf("Weighted tweet length must be between %d and %d characters.", v.Min, v.Max)
 }
 
 func (v tweetLengthValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
@@ -33,25 +70,118 @@ func (v tweetLengthValidator) Validate(ctx context.Context, req tfsdk.ValidateAt
 		return
 	}
 
-	strLen := len(str.Value)
+	v.validateText(req.AttributePath, str.Value, &resp.Diagnostics)
+}
+
+func (v tweetLengthValidator) validateText(path *tftypes.AttributePath, text string, diags *diag.Diagnostics) {
+	strLen := v.WeightedLength(text)
 
 	if strLen < v.Min || strLen > v.Max {
-		resp.Diagnostics.AddAttributeError(
-			req.AttributePath,
+		diags.AddAttributeError(
+			path,
 			"Invalid Tweet Length",
-			fmt.Sprintf("Tweet length must be between %d and %d characters, got: %d characters.", v.Min, v.Max, strLen),
+			fmt.Sprintf("Tweet length must be between %d and %d characters, got: %d weighted characters. "+
+				"URLs count as %d characters and CJK characters and emoji count as 2.", v.Min, v.Max, strLen, v.ShortURLLength),
 		)
+	}
+}
 
-		return
+// WeightedLength returns the length of text as counted by Twitter. The text is
+// NFC normalized, every URL counts as a t.co link and every code point of the
+// text between URLs is weighted according to weightRanges.
+func (v tweetLengthValidator) WeightedLength(text string) int {
+	text = norm.NFC.String(text)
+
+	weight := 0
+	end := 0
+
+	for _, match := range urlRegexp.FindAllStringIndex(text, -1) {
+		// The domain of an email address is not a URL.
+		if match[0] > 0 && text[match[0]-1] == '@' {
+			continue
+		}
+
+		// Trailing punctuation is not part of the URL.
+		url := strings.TrimRight(text[match[0]:match[1]], ".,;:!?'\")]")
+
+		weight += segmentWeight(text[end:match[0]])
+		weight += v.ShortURLLength * weightScale
+		end = match[0] + len(url)
+	}
+
+	weight += segmentWeight(text[end:])
+
+	return weight / weightScale
+}
+
+// segmentWeight returns the weight of text that contains no URL, scaled by
+// weightScale.
+func segmentWeight(text string) int {
+	weight := 0
+
+	var previous rune
+	for _, r := range text {
+		if !isEmojiContinuation(previous, r) {
+			// Modifiers and joined code points are part of the previous
+			// emoji.
+			weight += codePointWeight(r)
+		}
+
+		if isRegionalIndicator(previous) && isRegionalIndicator(r) {
+			// A flag is made of two regional indicators, the next one
+			// starts a new flag.
+			previous = 0
+			continue
+		}
+		previous = r
 	}
+
+	return weight
+}
+
+func codePointWeight(r rune) int {
+	for _, wr := range weightRanges {
+		if r >= wr.Start && r <= wr.End {
+			return wr.Weight
+		}
+	}
+
+	return defaultWeight
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1F1E6 && r <= 0x1F1FF
+}
+
+// isEmojiContinuation reports whether r extends the emoji sequence started by
+// previous, in which case the whole sequence is counted as a single emoji.
+func isEmojiContinuation(previous rune, r rune) bool {
+	switch {
+	case r == 0x200D, r == 0xFE0F, r == 0x20E3:
+		// Zero width joiner, emoji presentation selector and keycap.
+		return true
+	case r >= 0x1F3FB && r <= 0x1F3FF:
+		// Skin tone modifiers.
+		return true
+	case r >= 0xE0020 && r <= 0xE007F:
+		// Tag sequences used by subdivision flags.
+		return true
+	case previous == 0x200D:
+		return true
+	case isRegionalIndicator(previous) && isRegionalIndicator(r):
+		return true
+	}
+
+	return false
 }
 
 func TweetLength() tweetLengthValidator {
-	maxLength := 280
+	maxLength := maxWeightedTweetLength
 	minLength := 1
 
 	return tweetLengthValidator{
-		Max: maxLength,
-		Min: minLength,
+		Max:            maxLength,
+		Min:            minLength,
+		ShortURLLength: shortURLLength,
 	}
 }
@@ -0,0 +1,56 @@
+package validators
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestTweetLengthValidatorWeightedLength(t *testing.T) {
+	url := "https://registry.terraform.io/providers/sebastianmarines/twitter/latest"
+
+	testCases := []struct {
+		name    string
+		text    string
+		length  int
+		invalid bool
+	}{
+		{name: "ASCII", text: "Hello, world!", length: 13},
+		{name: "emoji", text: "👍", length: 2},
+		{name: "emoji with skin tone", text: "👍🏽", length: 2},
+		{name: "emoji sequence", text: "👨‍👩‍👧", length: 2},
+		{name: "flags", text: "🇺🇸🇫🇷", length: 4},
+		{name: "CJK", text: "語語語", length: 6},
+		{name: "accented NFC", text: "caf\u00e9", length: 4},
+		{name: "accented NFD", text: "cafe\u0301", length: 4},
+		{name: "URL", text: url, length: 23},
+		{name: "URL with trailing punctuation", text: "See " + url + ".", length: 28},
+		{name: "domain", text: "example.com", length: 23},
+		{name: "email", text: "dev@example.com", length: 15},
+		{name: "just under the limit", text: strings.Repeat("a", 255) + " " + url, length: 279},
+		{name: "at the limit", text: strings.Repeat("a", 256) + " " + url, length: 280},
+		{name: "over the limit", text: strings.Repeat("語", 141), length: 282, invalid: true},
+		{name: "empty", text: "", length: 0, invalid: true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			v := TweetLength()
+
+			if length := v.WeightedLength(tc.text); length != tc.length {
+				t.Errorf("expected a weighted length of %d, got %d", tc.length, length)
+			}
+
+			var diags diag.Diagnostics
+			v.validateText(tftypes.NewAttributePath().WithAttributeName("text"), tc.text, &diags)
+
+			if diags.HasError() != tc.invalid {
+				t.Errorf("expected invalid to be %t, got diagnostics %v", tc.invalid, diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type tweetListLengthValidator struct {
+	tweetLengthValidator
+	MinItems int
+}
+
+func (v tweetListLengthValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("Must contain at least %d tweets. %s", v.MinItems, v.tweetLengthValidator.Description(ctx))
+}
+
+func (v tweetListLengthValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("Must contain at least %d tweets. %s", v.MinItems, v.tweetLengthValidator.MarkdownDescription(ctx))
+}
+
+func (v tweetListLengthValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	var list types.List
+	diags := tfsdk.ValueAs(ctx, req.AttributeConfig, &list)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if list.Unknown || list.Null {
+		return
+	}
+
+	if len(list.Elems) < v.MinItems {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Number of Tweets",
+			fmt.Sprintf("Must contain at least %d tweets, got: %d.", v.MinItems, len(list.Elems)),
+		)
+
+		return
+	}
+
+	for i, elem := range list.Elems {
+		var str types.String
+		diags := tfsdk.ValueAs(ctx, elem, &str)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			continue
+		}
+
+		if str.Unknown || str.Null {
+			continue
+		}
+
+		v.validateText(req.AttributePath.WithElementKeyInt(i), str.Value, &resp.Diagnostics)
+	}
+}
+
+// TweetListLength validates that a list contains at least minItems tweets and
+// that every one of them passes the TweetLength validation.
+func TweetListLength(minItems int) tweetListLengthValidator {
+	return tweetListLengthValidator{
+		tweetLengthValidator: TweetLength(),
+		MinItems:             minItems,
+	}
+}